@@ -1,30 +1,309 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/renaldyhidayatt/movie_grpc/config"
 	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxImportLineSize bounds a single movie in an NDJSON import body.
+const maxImportLineSize = 1 << 20
+
 type Movie struct {
-	ID    string `json:"id"`
-	Title string `json:"Title"`
-	Genre string `json:"genre"`
+	ID               string   `json:"id"`
+	Title            string   `json:"Title"`
+	Genre            string   `json:"genre"`
+	Genres           []string `json:"genres"`
+	ReleaseYear      int32    `json:"release_year"`
+	RuntimeMinutes   int32    `json:"runtime_minutes"`
+	Synopsis         string   `json:"synopsis"`
+	OriginalLanguage string   `json:"original_language"`
+	AgeRating        string   `json:"age_rating"`
+	PosterURL        string   `json:"poster_url"`
+}
+
+type Genre struct {
+	Name string `json:"name"`
+}
+
+type Person struct {
+	Name      string `json:"name"`
+	Biography string `json:"biography"`
+}
+
+type Review struct {
+	Author string `json:"author"`
+	Score  int32  `json:"score"`
+	Text   string `json:"text"`
+}
+
+type Credit struct {
+	PersonID      string `json:"person_id"`
+	Role          string `json:"role"`
+	CharacterName string `json:"character_name"`
+	BillingOrder  int32  `json:"billing_order"`
+}
+
+// parseCreditRole accepts the enum name ("CREDIT_ROLE_CAST") or its short form
+// ("cast"). An empty string is CREDIT_ROLE_UNSPECIFIED.
+func parseCreditRole(role string) (pb.CreditRole, error) {
+	if role == "" {
+		return pb.CreditRole_CREDIT_ROLE_UNSPECIFIED, nil
+	}
+	name := strings.ToUpper(role)
+	if !strings.HasPrefix(name, "CREDIT_ROLE_") {
+		name = "CREDIT_ROLE_" + name
+	}
+	value, ok := pb.CreditRole_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid role %q", role)
+	}
+	return pb.CreditRole(value), nil
+}
+
+// parseMovieFilters copies the optional range filters from the query string
+// onto req. created_after is an RFC 3339 timestamp.
+func parseMovieFilters(ctx *gin.Context, req *pb.ReadMoviesRequest) error {
+	if v := ctx.Query("min_year"); v != "" {
+		year, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid min_year %q", v)
+		}
+		req.MinReleaseYear = int32(year)
+	}
+	if v := ctx.Query("max_year"); v != "" {
+		year, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid max_year %q", v)
+		}
+		req.MaxReleaseYear = int32(year)
+	}
+	if v := ctx.Query("created_after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid created_after %q", v)
+		}
+		req.CreatedAfter = timestamppb.New(t)
+	}
+	if v := ctx.Query("min_rating"); v != "" {
+		rating, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid min_rating %q", v)
+		}
+		req.MinRating = &rating
+	}
+	if v := ctx.Query("max_rating"); v != "" {
+		rating, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid max_rating %q", v)
+		}
+		req.MaxRating = &rating
+	}
+	return nil
+}
+
+// movieETag is the ETag of a movie: its version, as a strong validator.
+func movieETag(movie *pb.Movie) string {
+	return strconv.Quote(strconv.FormatInt(movie.GetVersion(), 10))
+}
+
+// parseIfMatch reads the movie version a write is conditioned on from the
+// If-Match header. No header, or "*", leaves the write unconditional.
+func parseIfMatch(ctx *gin.Context) (*int64, error) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	tag, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header %q", header)
+	}
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header %q", header)
+	}
+	return &version, nil
+}
+
+// httpStatus is the HTTP status of a failed call, following its gRPC code.
+// The only FailedPrecondition a route sees outside the trash is an If-Match
+// version that did not hold, hence 412.
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// trashErrorStatus is the HTTP status of a failed undelete or purge. A movie
+// that is not deleted is a 409 there, not a failed If-Match.
+func trashErrorStatus(err error) int {
+	if status.Code(err) == codes.FailedPrecondition {
+		return http.StatusConflict
+	}
+	return httpStatus(err)
+}
+
+// writeError responds to a failed call with its status and errorBody.
+func writeError(ctx *gin.Context, err error) {
+	ctx.JSON(httpStatus(err), errorBody(err))
+}
+
+// errorBody is the JSON body of a failed call. When the server named the
+// fields at fault, they are listed under "fields", keyed by field path, and
+// the resource it names, such as the movie a new one would duplicate, is
+// under "resource".
+func errorBody(err error) gin.H {
+	body := gin.H{
+		"error": err.Error(),
+	}
+	fields := map[string]string{}
+	for _, detail := range status.Convert(err).Details() {
+		switch detail := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range detail.GetFieldViolations() {
+				fields[violation.GetField()] = violation.GetDescription()
+			}
+		case *errdetails.ResourceInfo:
+			if detail.GetResourceName() != "" {
+				body["resource"] = gin.H{
+					"type": detail.GetResourceType(),
+					"name": detail.GetResourceName(),
+				}
+			}
+		}
+	}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+	return body
+}
+
+// toProto maps the request body onto a pb.Movie. Age ratings are accepted
+// either as the enum name ("AGE_RATING_PG_13") or its short form ("PG-13").
+func (m Movie) toProto() (*pb.Movie, error) {
+	rating := pb.AgeRating_AGE_RATING_UNSPECIFIED
+	if m.AgeRating != "" {
+		name := strings.ToUpper(strings.ReplaceAll(m.AgeRating, "-", "_"))
+		if !strings.HasPrefix(name, "AGE_RATING_") {
+			name = "AGE_RATING_" + name
+		}
+		value, ok := pb.AgeRating_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid age_rating %q", m.AgeRating)
+		}
+		rating = pb.AgeRating(value)
+	}
+
+	return &pb.Movie{
+		Id:               m.ID,
+		Title:            m.Title,
+		Genre:            m.Genre,
+		Genres:           m.Genres,
+		ReleaseYear:      m.ReleaseYear,
+		RuntimeMinutes:   m.RuntimeMinutes,
+		Synopsis:         m.Synopsis,
+		OriginalLanguage: m.OriginalLanguage,
+		AgeRating:        rating,
+		PosterUrl:        m.PosterURL,
+	}, nil
+}
+
+var protoJSON = protojson.MarshalOptions{UseProtoNames: true}
+
+// render encodes m with protojson so timestamps and enums come out in their
+// canonical JSON form rather than as raw struct fields.
+func render(m proto.Message) json.RawMessage {
+	data, err := protoJSON.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func renderAll[T proto.Message](messages []T) []json.RawMessage {
+	rendered := make([]json.RawMessage, len(messages))
+	for i, m := range messages {
+		rendered[i] = render(m)
+	}
+	return rendered
 }
 
 func main() {
-	flag.Parse()
+	cfg, err := config.LoadClient(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Effective configuration:\n%s", cfg)
+
+	shutdownTracerProvider, err := config.InitTracerProvider(context.Background(), "movie-grpc-gateway", cfg.Tracing.Endpoint)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracerProvider(context.Background())
 
 	var conn *grpc.ClientConn
 
-	conn, err := grpc.NewClient("server:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Calls carry the trace context, so a request's gateway and server spans
+	// end up in one trace.
+	conn, err = grpc.NewClient(cfg.ServerAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+			otelgrpc.WithTracerProvider(otel.GetTracerProvider()),
+			otelgrpc.WithPropagators(otel.GetTextMapPropagator()),
+		)),
+	)
 
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
@@ -33,6 +312,8 @@ func main() {
 	defer conn.Close()
 
 	client := pb.NewMovieServiceClient(conn)
+	peopleClient := pb.NewPeopleServiceClient(conn)
+	reviewClient := pb.NewReviewServiceClient(conn)
 
 	r := gin.Default()
 
@@ -48,67 +329,311 @@ func main() {
 		}
 
 		search := ctx.Query("search")
+		genre := ctx.Query("genre")
+		orderBy := ctx.Query("order_by")
 
 		req := &pb.ReadMoviesRequest{
 			Page:     int32(page),
 			PageSize: int32(pageSize),
 			Search:   search,
+			Genre:    genre,
+			OrderBy:  orderBy,
+		}
+		req.PageToken = ctx.Query("page_token")
+		if v := ctx.Query("include_total"); v != "" {
+			includeTotal, err := strconv.ParseBool(v)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("invalid include_total %q", v),
+				})
+				return
+			}
+			req.IncludeTotalCount = &includeTotal
+		}
+		if err := parseMovieFilters(ctx, req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
 		}
 
 		res, err := client.GetMovies(ctx, req)
 		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": err.Error(),
-			})
+			writeError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{
+			"movies":        renderAll(res.Movies),
+			"totalRecords":  res.TotalRecords,
+			"page":          page,
+			"pageSize":      pageSize,
+			"nextPageToken": res.NextPageToken,
+		})
+	})
+
+	r.GET("/movies/search", func(ctx *gin.Context) {
+		page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+		if err != nil || pageSize < 1 {
+			pageSize = 10
+		}
+
+		res, err := client.SearchMovies(ctx, &pb.SearchMoviesRequest{
+			Query:    ctx.Query("q"),
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
+		if err != nil {
+			writeError(ctx, err)
 			return
 		}
 
 		ctx.JSON(http.StatusOK, gin.H{
-			"movies":       res.Movies,
+			"results":      renderAll(res.Results),
 			"totalRecords": res.TotalRecords,
 			"page":         page,
 			"pageSize":     pageSize,
 		})
 	})
 
-	r.GET("/movies/:id", func(ctx *gin.Context) {
-		id := ctx.Param("id")
-		res, err := client.GetMovie(ctx, &pb.ReadMovieRequest{Id: id})
+	r.GET("/movies/export", func(ctx *gin.Context) {
+		batchSize, _ := strconv.Atoi(ctx.DefaultQuery("batch_size", "100"))
+		stream, err := client.StreamMovies(ctx, &pb.StreamMoviesRequest{BatchSize: int32(batchSize)})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+
+		// One protojson movie per line, the format /movies/import reads back.
+		ctx.Header("Content-Type", "application/x-ndjson")
+		ctx.Status(http.StatusOK)
+		for {
+			movie, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				log.Printf("movie export stopped: %v", err)
+				return
+			}
+			ctx.Writer.Write(render(movie))
+			ctx.Writer.WriteString("\n")
+			ctx.Writer.Flush()
+		}
+	})
+
+	r.GET("/movies/watch", func(ctx *gin.Context) {
+		req := &pb.WatchMoviesRequest{}
+		if v := ctx.Query("after_revision"); v != "" {
+			after, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": "after_revision must be an integer",
+				})
+				return
+			}
+			req.AfterRevision = &after
+		}
+		stream, err := client.WatchMovies(ctx, req)
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+
+		// One protojson event per line, flushed as soon as it arrives. The
+		// response ends when the client disconnects.
+		ctx.Header("Content-Type", "application/x-ndjson")
+		ctx.Status(http.StatusOK)
+		ctx.Writer.Flush()
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if status.Code(err) != codes.Canceled {
+					log.Printf("movie watch stopped: %v", err)
+				}
+				return
+			}
+			ctx.Writer.Write(render(event))
+			ctx.Writer.WriteString("\n")
+			ctx.Writer.Flush()
+		}
+	})
+
+	r.POST("/movies/import", func(ctx *gin.Context) {
+		stream, err := client.ImportMovies(ctx)
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"message": err.Error(),
+			writeError(ctx, err)
+			return
+		}
+
+		scanner := bufio.NewScanner(ctx.Request.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+		for line := 1; scanner.Scan(); line++ {
+			data := bytes.TrimSpace(scanner.Bytes())
+			if len(data) == 0 {
+				continue
+			}
+			var movie pb.Movie
+			if err := protojson.Unmarshal(data, &movie); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("line %d: %v", line, err),
+				})
+				return
+			}
+			if err := stream.Send(&pb.ImportMoviesRequest{Movie: &movie}); err != nil {
+				break
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
 			})
 			return
 		}
+
+		res, err := stream.CloseAndRecv()
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
 		ctx.JSON(http.StatusOK, gin.H{
-			"movie": res.Movie,
+			"summary": render(res),
 		})
 	})
+
+	r.POST("/movies/batch/get", func(ctx *gin.Context) {
+		var body struct {
+			IDs []string `json:"ids"`
+		}
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := client.BatchGetMovies(ctx, &pb.BatchGetMoviesRequest{Ids: body.IDs})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": renderAll(res.Results),
+		})
+	})
+
+	r.POST("/movies/batch/create", func(ctx *gin.Context) {
+		var body struct {
+			Movies []Movie `json:"movies"`
+		}
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		movies := make([]*pb.Movie, len(body.Movies))
+		for i, movie := range body.Movies {
+			data, err := movie.toProto()
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("movies[%d]: %v", i, err),
+				})
+				return
+			}
+			movies[i] = data
+		}
+		res, err := client.BatchCreateMovies(ctx, &pb.BatchCreateMoviesRequest{Movies: movies})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": renderAll(res.Results),
+		})
+	})
+
+	r.POST("/movies/batch/delete", func(ctx *gin.Context) {
+		var body struct {
+			IDs []string `json:"ids"`
+		}
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := client.BatchDeleteMovies(ctx, &pb.BatchDeleteMoviesRequest{Ids: body.IDs})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"results": renderAll(res.Results),
+		})
+	})
+
+	r.GET("/movies/:id", func(ctx *gin.Context) {
+		id := ctx.Param("id")
+		includeCredits, _ := strconv.ParseBool(ctx.Query("include_credits"))
+		res, err := client.GetMovie(ctx, &pb.ReadMovieRequest{
+			Id:             id,
+			IncludeCredits: includeCredits,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		body := gin.H{
+			"movie": render(res.Movie),
+		}
+		ctx.Header("ETag", movieETag(res.Movie))
+		if includeCredits {
+			body["credits"] = renderAll(res.Credits)
+		}
+		ctx.JSON(http.StatusOK, body)
+	})
 	r.POST("/movies", func(ctx *gin.Context) {
 		var movie Movie
 
 		err := ctx.ShouldBind(&movie)
 		if err != nil {
 			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
+				"error": err.Error(),
 			})
 			return
 		}
-		data := &pb.Movie{
-			Title: movie.Title,
-			Genre: movie.Genre,
-		}
-		res, err := client.CreateMovie(ctx, &pb.CreateMovieRequest{
-			Movie: data,
-		})
+		data, err := movie.toProto()
 		if err != nil {
 			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err,
+				"error": err.Error(),
 			})
 			return
 		}
+		// A retry carrying the same Idempotency-Key gets the movie the first
+		// attempt created rather than a new one.
+		rpcCtx := context.Context(ctx)
+		if key := ctx.GetHeader("Idempotency-Key"); key != "" {
+			rpcCtx = metadata.AppendToOutgoingContext(rpcCtx, "idempotency-key", key)
+		}
+		var header metadata.MD
+		res, err := client.CreateMovie(rpcCtx, &pb.CreateMovieRequest{
+			Movie: data,
+		}, grpc.Header(&header))
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		if len(header.Get("idempotent-replayed")) > 0 {
+			ctx.Header("Idempotent-Replayed", "true")
+		}
+		ctx.Header("ETag", movieETag(res.Movie))
 		ctx.JSON(http.StatusCreated, gin.H{
-			"movie": res.Movie,
+			"movie": render(res.Movie),
 		})
 	})
 	r.PUT("/movies/:id", func(ctx *gin.Context) {
@@ -120,33 +645,110 @@ func main() {
 			})
 			return
 		}
+		data, err := movie.toProto()
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		expected, err := parseIfMatch(ctx)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		res, err := client.UpdateMovie(ctx, &pb.UpdateMovieRequest{
-			Movie: &pb.Movie{
-				Id:    movie.ID,
-				Title: movie.Title,
-				Genre: movie.Genre,
-			},
+			Movie:           data,
+			ExpectedVersion: expected,
 		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", movieETag(res.Movie))
+		ctx.JSON(http.StatusOK, gin.H{
+			"movie": render(res.Movie),
+		})
+
+	})
+	r.PATCH("/movies/:id", func(ctx *gin.Context) {
+		body, err := io.ReadAll(ctx.Request.Body)
 		if err != nil {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
+		// The keys present in the body become the update mask, so a field
+		// sent as "" or 0 is cleared while absent fields are left alone.
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		var movie Movie
+		if err := json.Unmarshal(body, &movie); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		data, err := movie.toProto()
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		data.Id = ctx.Param("id")
+		expected, err := parseIfMatch(ctx)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		mask := &fieldmaskpb.FieldMask{}
+		for key := range fields {
+			if path := strings.ToLower(key); path != "id" {
+				mask.Paths = append(mask.Paths, path)
+			}
+		}
+		sort.Strings(mask.Paths)
+
+		res, err := client.UpdateMovie(ctx, &pb.UpdateMovieRequest{
+			Movie:           data,
+			UpdateMask:      mask,
+			ExpectedVersion: expected,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.Header("ETag", movieETag(res.Movie))
 		ctx.JSON(http.StatusOK, gin.H{
-			"movie": res.Movie,
+			"movie": render(res.Movie),
 		})
-
 	})
 	r.DELETE("/movies/:id", func(ctx *gin.Context) {
 		id := ctx.Param("id")
-		res, err := client.DeleteMovie(ctx, &pb.DeleteMovieRequest{Id: id})
+		expected, err := parseIfMatch(ctx)
 		if err != nil {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 			return
 		}
+		res, err := client.DeleteMovie(ctx, &pb.DeleteMovieRequest{Id: id, ExpectedVersion: expected})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
 		if res.Success == true {
 			ctx.JSON(http.StatusOK, gin.H{
 				"message": "Movie deleted successfully",
@@ -160,7 +762,387 @@ func main() {
 		}
 
 	})
+	r.GET("/movies/deleted", func(ctx *gin.Context) {
+		page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+		if err != nil || pageSize < 1 {
+			pageSize = 10
+		}
+
+		res, err := client.ListDeletedMovies(ctx, &pb.ListDeletedMoviesRequest{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"movies":       renderAll(res.Movies),
+			"totalRecords": res.TotalRecords,
+			"page":         page,
+			"pageSize":     pageSize,
+		})
+	})
+	r.GET("/movies/duplicates", func(ctx *gin.Context) {
+		req := &pb.FindDuplicatesRequest{}
+		if v := ctx.Query("min_similarity"); v != "" {
+			similarity, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("invalid min_similarity %q", v),
+				})
+				return
+			}
+			req.MinSimilarity = similarity
+		}
+		if v := ctx.Query("limit"); v != "" {
+			limit, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{
+					"error": fmt.Sprintf("invalid limit %q", v),
+				})
+				return
+			}
+			req.Limit = int32(limit)
+		}
+
+		res, err := client.FindDuplicates(ctx, req)
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"duplicates": renderAll(res.Duplicates),
+		})
+	})
+	r.POST("/movies/:id/undelete", func(ctx *gin.Context) {
+		res, err := client.UndeleteMovie(ctx, &pb.UndeleteMovieRequest{Id: ctx.Param("id")})
+		if err != nil {
+			ctx.JSON(trashErrorStatus(err), errorBody(err))
+			return
+		}
+		ctx.Header("ETag", movieETag(res.Movie))
+		ctx.JSON(http.StatusOK, gin.H{
+			"movie": render(res.Movie),
+		})
+	})
+	r.DELETE("/movies/:id/purge", func(ctx *gin.Context) {
+		_, err := client.PurgeMovie(ctx, &pb.PurgeMovieRequest{Id: ctx.Param("id")})
+		if err != nil {
+			ctx.JSON(trashErrorStatus(err), errorBody(err))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": "Movie purged successfully",
+		})
+	})
+
+	r.GET("/genres", func(ctx *gin.Context) {
+		res, err := client.GetGenres(ctx, &pb.ReadGenresRequest{})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"genres": renderAll(res.Genres),
+		})
+	})
+	r.GET("/genres/:id", func(ctx *gin.Context) {
+		res, err := client.GetGenre(ctx, &pb.ReadGenreRequest{Id: ctx.Param("id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"genre": render(res.Genre),
+		})
+	})
+	r.POST("/genres", func(ctx *gin.Context) {
+		var genre Genre
+		if err := ctx.ShouldBind(&genre); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := client.CreateGenre(ctx, &pb.CreateGenreRequest{
+			Genre: &pb.Genre{Name: genre.Name},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusCreated, gin.H{
+			"genre": render(res.Genre),
+		})
+	})
+	r.PUT("/genres/:id", func(ctx *gin.Context) {
+		var genre Genre
+		if err := ctx.ShouldBind(&genre); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := client.UpdateGenre(ctx, &pb.UpdateGenreRequest{
+			Genre: &pb.Genre{Id: ctx.Param("id"), Name: genre.Name},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"genre": render(res.Genre),
+		})
+	})
+	r.DELETE("/genres/:id", func(ctx *gin.Context) {
+		_, err := client.DeleteGenre(ctx, &pb.DeleteGenreRequest{Id: ctx.Param("id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": "Genre deleted successfully",
+		})
+	})
+
+	r.GET("/people", func(ctx *gin.Context) {
+		page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+		if err != nil || pageSize < 1 {
+			pageSize = 10
+		}
+
+		res, err := peopleClient.GetPeople(ctx, &pb.ReadPeopleRequest{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+			Search:   ctx.Query("search"),
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"people":       renderAll(res.People),
+			"totalRecords": res.TotalRecords,
+			"page":         page,
+			"pageSize":     pageSize,
+		})
+	})
+	r.GET("/people/:id", func(ctx *gin.Context) {
+		res, err := peopleClient.GetPerson(ctx, &pb.ReadPersonRequest{Id: ctx.Param("id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"person": render(res.Person),
+		})
+	})
+	r.GET("/people/:id/movies", func(ctx *gin.Context) {
+		role, err := parseCreditRole(ctx.Query("role"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := peopleClient.GetPersonMovies(ctx, &pb.ReadPersonMoviesRequest{
+			PersonId: ctx.Param("id"),
+			Role:     role,
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"movies": renderAll(res.Movies),
+		})
+	})
+	r.POST("/people", func(ctx *gin.Context) {
+		var person Person
+		if err := ctx.ShouldBind(&person); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := peopleClient.CreatePerson(ctx, &pb.CreatePersonRequest{
+			Person: &pb.Person{Name: person.Name, Biography: person.Biography},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusCreated, gin.H{
+			"person": render(res.Person),
+		})
+	})
+	r.PUT("/people/:id", func(ctx *gin.Context) {
+		var person Person
+		if err := ctx.ShouldBind(&person); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := peopleClient.UpdatePerson(ctx, &pb.UpdatePersonRequest{
+			Person: &pb.Person{Id: ctx.Param("id"), Name: person.Name, Biography: person.Biography},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"person": render(res.Person),
+		})
+	})
+	r.DELETE("/people/:id", func(ctx *gin.Context) {
+		_, err := peopleClient.DeletePerson(ctx, &pb.DeletePersonRequest{Id: ctx.Param("id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": "Person deleted successfully",
+		})
+	})
+	r.POST("/movies/:id/credits", func(ctx *gin.Context) {
+		var credit Credit
+		if err := ctx.ShouldBind(&credit); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		role, err := parseCreditRole(credit.Role)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := peopleClient.CreateCredit(ctx, &pb.CreateCreditRequest{
+			Credit: &pb.Credit{
+				MovieId:       ctx.Param("id"),
+				PersonId:      credit.PersonID,
+				Role:          role,
+				CharacterName: credit.CharacterName,
+				BillingOrder:  credit.BillingOrder,
+			},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusCreated, gin.H{
+			"credit": render(res.Credit),
+		})
+	})
+	r.DELETE("/credits/:id", func(ctx *gin.Context) {
+		_, err := peopleClient.DeleteCredit(ctx, &pb.DeleteCreditRequest{Id: ctx.Param("id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": "Credit deleted successfully",
+		})
+	})
+	r.GET("/movies/:id/reviews", func(ctx *gin.Context) {
+		page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+
+		pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+		if err != nil || pageSize < 1 {
+			pageSize = 10
+		}
+
+		res, err := reviewClient.GetReviews(ctx, &pb.ReadReviewsRequest{
+			MovieId:  ctx.Param("id"),
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"reviews":      renderAll(res.Reviews),
+			"totalRecords": res.TotalRecords,
+			"page":         page,
+			"pageSize":     pageSize,
+		})
+	})
+	r.POST("/movies/:id/reviews", func(ctx *gin.Context) {
+		var review Review
+		if err := ctx.ShouldBind(&review); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := reviewClient.CreateReview(ctx, &pb.CreateReviewRequest{
+			Review: &pb.Review{
+				MovieId: ctx.Param("id"),
+				Author:  review.Author,
+				Score:   review.Score,
+				Text:    review.Text,
+			},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusCreated, gin.H{
+			"review": render(res.Review),
+		})
+	})
+	r.PUT("/reviews/:id", func(ctx *gin.Context) {
+		var review Review
+		if err := ctx.ShouldBind(&review); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		res, err := reviewClient.UpdateReview(ctx, &pb.UpdateReviewRequest{
+			Review: &pb.Review{
+				Id:    ctx.Param("id"),
+				Score: review.Score,
+				Text:  review.Text,
+			},
+		})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"review": render(res.Review),
+		})
+	})
+	r.DELETE("/reviews/:id", func(ctx *gin.Context) {
+		_, err := reviewClient.DeleteReview(ctx, &pb.DeleteReviewRequest{Id: ctx.Param("id")})
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": "Review deleted successfully",
+		})
+	})
 
-	r.Run(":5000")
+	r.Run(cfg.HTTPAddress)
 
 }
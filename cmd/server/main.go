@@ -4,12 +4,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -21,6 +25,7 @@ import (
 	mencache "github.com/renaldyhidayatt/movie_grpc/redis"
 	"github.com/renaldyhidayatt/movie_grpc/repository"
 	"github.com/renaldyhidayatt/movie_grpc/service"
+	"github.com/renaldyhidayatt/movie_grpc/validation"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
@@ -29,51 +34,76 @@ import (
 	"gorm.io/gorm"
 )
 
-func init() {
-	DatabaseConnection()
-}
-
 var DB *gorm.DB
 var err error
 
-func DatabaseConnection() {
-	dbPath := "movie_grpc.db"
-	DB, err = gorm.Open(sqlite.Open(dbPath), &gorm.Config{})
+func DatabaseConnection(dbPath string) {
+	DB, err = gorm.Open(sqlite.Open(dbPath), &gorm.Config{NowFunc: repository.Now})
 	if err != nil {
 		log.Fatal("Error connecting to the database...", err)
 	}
 
-	if err := DB.AutoMigrate(&models.Movie{}); err != nil {
+	if err := DB.AutoMigrate(&models.Movie{}, &models.Genre{}, &models.Person{}, &models.Credit{}, &models.Review{}, &models.MovieEvent{}, &models.IdempotencyKey{}); err != nil {
 		log.Fatalf("Error during migration: %v", err)
 	}
 
+	if err := repository.MigrateTimestampsToUTC(DB); err != nil {
+		log.Fatalf("Error migrating timestamps: %v", err)
+	}
+
+	if err := repository.MigrateLegacyGenres(DB); err != nil {
+		log.Fatalf("Error migrating legacy genres: %v", err)
+	}
+
+	search, err := repository.MigrateMovieSearch(DB)
+	if err != nil {
+		log.Fatalf("Error migrating movie search index: %v", err)
+	}
+	if !search {
+		fmt.Println("SQLite was built without FTS5, movie search falls back to LIKE...")
+	}
+
+	duplicates, err := repository.MigrateMovieTitles(DB)
+	if err != nil {
+		log.Fatalf("Error migrating movie titles: %v", err)
+	}
+	if duplicates > 0 {
+		fmt.Printf("%d movies duplicate the title and release year of another, see FindDuplicates...\n", duplicates)
+	}
+
 	fmt.Println("Database connection successful using SQLite...")
 }
 
 func main() {
+	cfg, err := config.LoadServer(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Effective configuration:\n%s", cfg)
+
 	fmt.Println("gRPC server running ...")
-	ctx := context.Background()
-	flag.Parse()
+	// ctx is cancelled by SIGINT or SIGTERM, which starts the shutdown and
+	// stops the background work started with it.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	grpcLis, err := net.Listen("tcp", ":50051")
+	grpcLis, err := net.Listen("tcp", cfg.GRPCAddress)
 	if err != nil {
 		log.Fatalf("Failed to listen for gRPC: %v", err)
 	}
 
-	metricsLis, err := net.Listen("tcp", ":8080")
+	metricsLis, err := net.Listen("tcp", cfg.MetricsAddress)
 	if err != nil {
 		log.Fatalf("Failed to listen for metrics: %v", err)
 	}
 
-	shutdownTracerProvider, err := config.InitTracerProvider(ctx)
+	shutdownTracerProvider, err := config.InitTracerProvider(ctx, "movie-grpc-service", cfg.Tracing.Endpoint)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer func() {
-		if err := shutdownTracerProvider(ctx); err != nil {
-			log.Fatalf("failed to shutdown TracerProvider: %s", err)
-		}
-	}()
 
 	tracer := otel.Tracer("hello")
 	logger, err := logger.NewLogger()
@@ -82,16 +112,71 @@ func main() {
 		log.Fatal(err)
 	}
 
-	redisClient := redis.NewClient(&redis.Options{
-		Addr:     "redis:6379",
-		Password: "",
-		DB:       0,
-	})
+	var (
+		movieRepo  repository.MovieRepository
+		genreRepo  repository.GenreRepository
+		personRepo repository.PersonRepository
+		creditRepo repository.CreditRepository
+		reviewRepo repository.ReviewRepository
+		eventRepo  repository.MovieEventRepository
+		movieCache mencache.MovieServiceCache
 
-	mencache := mencache.NewMovieServiceCache(redisClient, 10*time.Minute)
+		redisClient *redis.Client
+	)
+
+	if cfg.InMemory {
+		log.Println("Using in-memory storage and cache")
+		memoryDB := repository.NewMemoryDB()
+		movieRepo = repository.NewMemoryMovieRepository(memoryDB)
+		genreRepo = repository.NewMemoryGenreRepository(memoryDB)
+		personRepo = repository.NewMemoryPersonRepository(memoryDB)
+		creditRepo = repository.NewMemoryCreditRepository(memoryDB)
+		reviewRepo = repository.NewMemoryReviewRepository(memoryDB)
+		eventRepo = repository.NewMemoryMovieEventRepository(memoryDB)
+		movieCache = mencache.NewInMemoryMovieServiceCache(cfg.Cache.TTL)
+	} else {
+		DatabaseConnection(cfg.Database.Path)
+		movieRepo = repository.NewMovieRepository(DB)
+		genreRepo = repository.NewGenreRepository(DB)
+		personRepo = repository.NewPersonRepository(DB)
+		creditRepo = repository.NewCreditRepository(DB)
+		reviewRepo = repository.NewReviewRepository(DB)
+		eventRepo = repository.NewMovieEventRepository(DB)
+
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Address,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+
+		movieCache = mencache.NewMovieServiceCache(
+			redisClient,
+			cfg.Cache.TTL,
+			mencache.WithEarlyRefresh(cfg.Cache.EarlyRefresh, cfg.Cache.EarlyRefreshBeta),
+		)
+		if cfg.Cache.L1Size > 0 {
+			movieCache = mencache.NewTieredMovieCache(
+				ctx,
+				mencache.NewLRUMovieServiceCache(cfg.Cache.L1Size, cfg.Cache.L1TTL),
+				movieCache,
+				redisClient,
+				logger,
+			)
+		}
+	}
 
-	movieRepo := repository.NewMovieRepository(DB)
-	movieService := service.NewMovieService(movieRepo, tracer, logger, mencache)
+	movieService := service.NewMovieService(movieRepo, genreRepo, creditRepo, eventRepo, tracer, logger, movieCache, service.WithBatchLimit(cfg.Movies.BatchLimit), service.WithIdempotencyWindow(cfg.Movies.IdempotencyWindow), service.WithWatchPollInterval(cfg.Movies.WatchPollInterval))
+	peopleService := service.NewPeopleService(personRepo, creditRepo, tracer, logger)
+	reviewService := service.NewReviewService(reviewRepo, tracer, logger, movieCache)
+
+	var background sync.WaitGroup
+	if cfg.Movies.PurgeAfterDays > 0 || cfg.Movies.EventRetention > 0 {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			movieService.Cleanup(ctx, cfg.Movies.PurgeInterval, time.Duration(cfg.Movies.PurgeAfterDays)*24*time.Hour, cfg.Movies.EventRetention)
+		}()
+	}
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(
@@ -100,31 +185,113 @@ func main() {
 				otelgrpc.WithPropagators(otel.GetTextMapPropagator()),
 			),
 		),
+		grpc.ChainUnaryInterceptor(
+			service.RecoveryUnaryServerInterceptor(logger),
+			validation.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			service.RecoveryStreamServerInterceptor(logger),
+			validation.StreamServerInterceptor(),
+		),
 	)
 
-	metricsServer := http.NewServeMux()
-	metricsServer.Handle("/metrics", promhttp.Handler())
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", promhttp.Handler())
+	metricsServer := &http.Server{Handler: metricsMux}
 
 	pb.RegisterMovieServiceServer(grpcServer, movieService)
+	pb.RegisterPeopleServiceServer(grpcServer, peopleService)
+	pb.RegisterReviewServiceServer(grpcServer, reviewService)
 
-	var wg sync.WaitGroup
-	wg.Add(2)
+	// A server that stops on its own reports to serveErr, which shuts the
+	// other one down too.
+	serveErr := make(chan error, 2)
 
 	go func() {
-		defer wg.Done()
-		log.Println("Metrics server listening on :8080")
-		if err := http.Serve(metricsLis, metricsServer); err != nil {
-			log.Printf("Metrics server error: %v", err)
+		log.Printf("Metrics server listening on %s", cfg.MetricsAddress)
+		if err := metricsServer.Serve(metricsLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("metrics server: %w", err)
 		}
 	}()
 
 	go func() {
-		defer wg.Done()
-		log.Println("gRPC server listening on :50051")
+		log.Printf("gRPC server listening on %s", cfg.GRPCAddress)
 		if err := grpcServer.Serve(grpcLis); err != nil {
-			log.Printf("gRPC server error: %v", err)
+			serveErr <- fmt.Errorf("gRPC server: %w", err)
+		}
+	}()
+
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+		log.Println("Shutting down ...")
+	case err := <-serveErr:
+		log.Printf("Shutting down after error: %v", err)
+		exitCode = 1
+	}
+	stop()
+
+	// One deadline covers the whole shutdown, so it fits in the grace period
+	// the container runtime allows before it kills the process.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	movieService.Stop()
+	if !drain(shutdownCtx, grpcServer) {
+		log.Printf("In-flight RPCs did not finish within %s, closing them", cfg.ShutdownTimeout)
+		exitCode = 1
+	}
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Failed to shut down metrics server: %v", err)
+		exitCode = 1
+	}
+	background.Wait()
+
+	if err := shutdownTracerProvider(shutdownCtx); err != nil {
+		log.Printf("Failed to flush traces: %v", err)
+		exitCode = 1
+	}
+	if redisClient != nil {
+		if err := redisClient.Close(); err != nil {
+			log.Printf("Failed to close Redis: %v", err)
+			exitCode = 1
+		}
+	}
+	if DB != nil {
+		if err := closeDatabase(DB); err != nil {
+			log.Printf("Failed to close database: %v", err)
+			exitCode = 1
 		}
+	}
+
+	log.Println("Server stopped")
+	os.Exit(exitCode)
+}
+
+// drain stops the gRPC server from accepting connections and waits for
+// in-flight RPCs to finish. If ctx ends first, it closes the remaining ones
+// and reports false.
+func drain(ctx context.Context, grpcServer *grpc.Server) bool {
+	done := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(done)
 	}()
 
-	wg.Wait()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		grpcServer.Stop()
+		<-done
+		return false
+	}
+}
+
+func closeDatabase(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
 }
@@ -3,6 +3,8 @@ package config
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"go.opentelemetry.io/otel"
 
@@ -15,8 +17,14 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func InitConn() (*grpc.ClientConn, error) {
-	conn, err := grpc.NewClient("jaeger:4317",
+// InitConn connects to the OTLP gRPC collector at endpoint, given in the
+// forms InitTracerProvider accepts.
+func InitConn(endpoint string) (*grpc.ClientConn, error) {
+	target := endpoint
+	if u, err := url.Parse(endpoint); err == nil && strings.Contains(endpoint, "://") {
+		target = u.Host
+	}
+	conn, err := grpc.NewClient(target,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
@@ -26,11 +34,18 @@ func InitConn() (*grpc.ClientConn, error) {
 	return conn, err
 }
 
-func InitTracerProvider(ctx context.Context) (func(context.Context) error, error) {
+// InitTracerProvider exports the traces of service to the OTLP gRPC
+// collector at endpoint, given as host:port or as a URL such as
+// http://otel-collector:4317.
+func InitTracerProvider(ctx context.Context, service, endpoint string) (func(context.Context) error, error) {
+	target := otlptracegrpc.WithEndpoint(endpoint)
+	if strings.Contains(endpoint, "://") {
+		target = otlptracegrpc.WithEndpointURL(endpoint)
+	}
 	traceExporter, err := otlptracegrpc.New(
 		ctx,
 		otlptracegrpc.WithInsecure(),
-		otlptracegrpc.WithEndpoint("otel-collector:4317"),
+		target,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
@@ -39,7 +54,7 @@ func InitTracerProvider(ctx context.Context) (func(context.Context) error, error
 	res, err := resource.New(
 		ctx,
 		resource.WithAttributes(
-			semconv.ServiceNameKey.String("movie-grpc-service"),
+			semconv.ServiceNameKey.String(service),
 			semconv.ServiceVersionKey.String("1.0.0"),
 		),
 	)
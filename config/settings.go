@@ -0,0 +1,363 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted stands in for secrets when a config is printed.
+const redacted = "REDACTED"
+
+// Server configures cmd/server. Load it with LoadServer.
+type Server struct {
+	GRPCAddress    string   `yaml:"grpc_address"`
+	MetricsAddress string   `yaml:"metrics_address"`
+	InMemory       bool     `yaml:"in_memory"`
+	Database       Database `yaml:"database"`
+	Redis          Redis    `yaml:"redis"`
+	Cache          Cache    `yaml:"cache"`
+	Tracing        Tracing  `yaml:"tracing"`
+	Movies         Movies   `yaml:"movies"`
+
+	// ShutdownTimeout bounds the whole shutdown: waiting for in-flight RPCs,
+	// then flushing traces. It must stay below the stop_grace_period in
+	// docker-compose.yml, after which the server is killed.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type Database struct {
+	Path string `yaml:"path"`
+}
+
+type Redis struct {
+	Address  string `yaml:"address"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+}
+
+// Cache configures the movie cache: entries live for TTL in Redis, or in
+// memory with -in-memory, and the in-process L1 cache in front of Redis holds
+// up to L1Size entries for L1TTL. Redis reads refresh entries early as they
+// near expiry: EarlyRefresh is roughly how long a reload takes and
+// EarlyRefreshBeta above 1 refreshes sooner. An EarlyRefresh of 0 disables it.
+type Cache struct {
+	TTL              time.Duration `yaml:"ttl"`
+	L1Size           int           `yaml:"l1_size"`
+	L1TTL            time.Duration `yaml:"l1_ttl"`
+	EarlyRefresh     time.Duration `yaml:"early_refresh"`
+	EarlyRefreshBeta float64       `yaml:"early_refresh_beta"`
+}
+
+type Tracing struct {
+	// Endpoint is the OTLP gRPC collector, as host:port or as a URL such as
+	// http://otel-collector:4317.
+	Endpoint string `yaml:"endpoint"`
+}
+
+// Movies configures the movie service. PurgeInterval is how often the
+// cleanup loop purges deleted movies older than PurgeAfterDays and prunes
+// change events older than EventRetention; WatchMovies streams poll for new
+// events every WatchPollInterval.
+type Movies struct {
+	BatchLimit        int           `yaml:"batch_limit"`
+	IdempotencyWindow time.Duration `yaml:"idempotency_window"`
+	PurgeAfterDays    int           `yaml:"purge_after_days"`
+	PurgeInterval     time.Duration `yaml:"purge_interval"`
+	EventRetention    time.Duration `yaml:"event_retention"`
+	WatchPollInterval time.Duration `yaml:"watch_poll_interval"`
+}
+
+// Client configures the cmd/client HTTP gateway. Load it with LoadClient.
+type Client struct {
+	HTTPAddress   string  `yaml:"http_address"`
+	ServerAddress string  `yaml:"server_address"`
+	Tracing       Tracing `yaml:"tracing"`
+}
+
+// DefaultServer is the server config before any file, environment variable
+// or flag changes it. It matches docker-compose.yml.
+func DefaultServer() Server {
+	return Server{
+		GRPCAddress:    ":50051",
+		MetricsAddress: ":8080",
+		Database:       Database{Path: "movie_grpc.db"},
+		Redis:          Redis{Address: "redis:6379"},
+		Cache: Cache{
+			TTL:              10 * time.Minute,
+			L1Size:           1000,
+			L1TTL:            30 * time.Second,
+			EarlyRefresh:     100 * time.Millisecond,
+			EarlyRefreshBeta: 1.0,
+		},
+		Tracing: Tracing{Endpoint: "otel-collector:4317"},
+		Movies: Movies{
+			BatchLimit:        100,
+			IdempotencyWindow: 24 * time.Hour,
+			PurgeAfterDays:    30,
+			PurgeInterval:     time.Hour,
+			EventRetention:    7 * 24 * time.Hour,
+			WatchPollInterval: 500 * time.Millisecond,
+		},
+		ShutdownTimeout: 8 * time.Second,
+	}
+}
+
+// DefaultClient is the gateway config before any file, environment variable
+// or flag changes it.
+func DefaultClient() Client {
+	return Client{
+		HTTPAddress:   ":5000",
+		ServerAddress: "server:50051",
+		Tracing:       Tracing{Endpoint: "otel-collector:4317"},
+	}
+}
+
+// LoadServer builds the server config from DefaultServer, then the YAML file
+// named by -config or CONFIG_FILE, then environment variables, then the
+// flags in args, each overriding the one before. The result is validated.
+func LoadServer(args []string) (Server, error) {
+	cfg := DefaultServer()
+
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.StringVar(&cfg.GRPCAddress, "grpc-address", cfg.GRPCAddress, "address the gRPC server listens on")
+	fs.StringVar(&cfg.MetricsAddress, "metrics-address", cfg.MetricsAddress, "address the Prometheus metrics server listens on")
+	fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "how long shutting down on SIGINT or SIGTERM may take")
+	fs.BoolVar(&cfg.InMemory, "in-memory", cfg.InMemory, "use in-memory storage and cache instead of SQLite and Redis")
+	fs.StringVar(&cfg.Database.Path, "database-path", cfg.Database.Path, "path of the SQLite database")
+	fs.StringVar(&cfg.Redis.Address, "redis-address", cfg.Redis.Address, "address of the Redis cache")
+	fs.StringVar(&cfg.Redis.Password, "redis-password", cfg.Redis.Password, "password of the Redis cache")
+	fs.IntVar(&cfg.Redis.DB, "redis-db", cfg.Redis.DB, "Redis database number")
+	fs.DurationVar(&cfg.Cache.TTL, "cache-ttl", cfg.Cache.TTL, "expiration of entries in the movie cache")
+	fs.IntVar(&cfg.Cache.L1Size, "l1-cache-size", cfg.Cache.L1Size, "maximum number of entries in the in-process movie cache (0 disables it)")
+	fs.DurationVar(&cfg.Cache.L1TTL, "l1-cache-ttl", cfg.Cache.L1TTL, "expiration of entries in the in-process movie cache")
+	fs.DurationVar(&cfg.Cache.EarlyRefresh, "cache-early-refresh", cfg.Cache.EarlyRefresh, "how long before expiry Redis cache entries may be refreshed, roughly one reload (0 disables early refresh)")
+	fs.Float64Var(&cfg.Cache.EarlyRefreshBeta, "cache-early-refresh-beta", cfg.Cache.EarlyRefreshBeta, "how eagerly Redis cache entries are refreshed early; above 1 refreshes sooner")
+	fs.StringVar(&cfg.Tracing.Endpoint, "otlp-endpoint", cfg.Tracing.Endpoint, "OTLP gRPC endpoint traces are exported to")
+	fs.IntVar(&cfg.Movies.BatchLimit, "batch-limit", cfg.Movies.BatchLimit, "maximum number of items in one batch call")
+	fs.DurationVar(&cfg.Movies.IdempotencyWindow, "idempotency-window", cfg.Movies.IdempotencyWindow, "how long CreateMovie idempotency keys are remembered (0 disables them)")
+	fs.IntVar(&cfg.Movies.PurgeAfterDays, "purge-after-days", cfg.Movies.PurgeAfterDays, "purge movies that have been deleted for more than this many days (0 disables purging)")
+	fs.DurationVar(&cfg.Movies.PurgeInterval, "purge-interval", cfg.Movies.PurgeInterval, "how often deleted movies and old movie events are cleaned up")
+	fs.DurationVar(&cfg.Movies.EventRetention, "event-retention", cfg.Movies.EventRetention, "prune WatchMovies events older than this (0 keeps them forever)")
+	fs.DurationVar(&cfg.Movies.WatchPollInterval, "watch-poll-interval", cfg.Movies.WatchPollInterval, "how often WatchMovies streams poll for new events")
+
+	err := load(fs, args, &cfg, map[string]string{
+		"grpc-address":             "GRPC_ADDRESS",
+		"metrics-address":          "METRICS_ADDRESS",
+		"shutdown-timeout":         "SHUTDOWN_TIMEOUT",
+		"in-memory":                "IN_MEMORY",
+		"database-path":            "DATABASE_PATH",
+		"redis-address":            "REDIS_ADDRESS",
+		"redis-password":           "REDIS_PASSWORD",
+		"redis-db":                 "REDIS_DB",
+		"cache-ttl":                "CACHE_TTL",
+		"l1-cache-size":            "L1_CACHE_SIZE",
+		"l1-cache-ttl":             "L1_CACHE_TTL",
+		"cache-early-refresh":      "CACHE_EARLY_REFRESH",
+		"cache-early-refresh-beta": "CACHE_EARLY_REFRESH_BETA",
+		"otlp-endpoint":            "OTEL_EXPORTER_OTLP_ENDPOINT",
+		"batch-limit":              "BATCH_LIMIT",
+		"idempotency-window":       "IDEMPOTENCY_WINDOW",
+		"purge-after-days":         "PURGE_AFTER_DAYS",
+		"purge-interval":           "PURGE_INTERVAL",
+		"event-retention":          "EVENT_RETENTION",
+		"watch-poll-interval":      "WATCH_POLL_INTERVAL",
+	})
+	if err != nil {
+		return Server{}, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// LoadClient builds the gateway config the way LoadServer builds the
+// server's.
+func LoadClient(args []string) (Client, error) {
+	cfg := DefaultClient()
+
+	fs := flag.NewFlagSet("client", flag.ContinueOnError)
+	fs.StringVar(&cfg.HTTPAddress, "http-address", cfg.HTTPAddress, "address the HTTP gateway listens on")
+	fs.StringVar(&cfg.ServerAddress, "server-address", cfg.ServerAddress, "address of the gRPC server")
+	fs.StringVar(&cfg.Tracing.Endpoint, "otlp-endpoint", cfg.Tracing.Endpoint, "OTLP gRPC endpoint traces are exported to")
+
+	err := load(fs, args, &cfg, map[string]string{
+		"http-address":   "HTTP_ADDRESS",
+		"server-address": "GRPC_SERVER_ADDRESS",
+		"otlp-endpoint":  "OTEL_EXPORTER_OTLP_ENDPOINT",
+	})
+	if err != nil {
+		return Client{}, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// load fills cfg, whose fields fs is bound to, in order of precedence. The
+// flags are parsed first, to find the config file, and applied again last so
+// that they win over the file and the environment variables named in env.
+func load(fs *flag.FlagSet, args []string, cfg any, env map[string]string) error {
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML config file (env CONFIG_FILE)")
+	for name, variable := range env {
+		f := fs.Lookup(name)
+		f.Usage += fmt.Sprintf(" (env %s)", variable)
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	flags := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) {
+		flags[f.Name] = f.Value.String()
+	})
+
+	if *path != "" {
+		if err := loadFile(*path, cfg); err != nil {
+			return err
+		}
+	}
+
+	for name, variable := range env {
+		value, ok := os.LookupEnv(variable)
+		if !ok {
+			continue
+		}
+		if err := fs.Set(name, value); err != nil {
+			return fmt.Errorf("invalid %s: %w", variable, err)
+		}
+	}
+
+	for name, value := range flags {
+		if err := fs.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadFile overrides cfg with the fields set in the YAML file at path.
+// Unknown fields are an error, so a misspelled setting does not go unnoticed.
+func loadFile(path string, cfg any) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports every setting that the server cannot start with.
+func (c Server) Validate() error {
+	var errs []error
+	errs = append(errs, checkAddress("grpc_address", c.GRPCAddress))
+	errs = append(errs, checkAddress("metrics_address", c.MetricsAddress))
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout must be positive"))
+	}
+	if !c.InMemory {
+		if c.Database.Path == "" {
+			errs = append(errs, errors.New("database.path is required"))
+		}
+		errs = append(errs, checkAddress("redis.address", c.Redis.Address))
+		if c.Redis.DB < 0 {
+			errs = append(errs, errors.New("redis.db must not be negative"))
+		}
+	}
+	if c.Cache.TTL <= 0 {
+		errs = append(errs, errors.New("cache.ttl must be positive"))
+	}
+	if c.Cache.L1Size < 0 {
+		errs = append(errs, errors.New("cache.l1_size must not be negative"))
+	}
+	if c.Cache.L1Size > 0 && c.Cache.L1TTL <= 0 {
+		errs = append(errs, errors.New("cache.l1_ttl must be positive when the L1 cache is enabled"))
+	}
+	if c.Cache.EarlyRefresh < 0 {
+		errs = append(errs, errors.New("cache.early_refresh must not be negative"))
+	}
+	if c.Cache.EarlyRefresh > 0 && c.Cache.EarlyRefreshBeta <= 0 {
+		errs = append(errs, errors.New("cache.early_refresh_beta must be positive when early refresh is enabled"))
+	}
+	if c.Tracing.Endpoint == "" {
+		errs = append(errs, errors.New("tracing.endpoint is required"))
+	}
+	if c.Movies.BatchLimit < 1 {
+		errs = append(errs, errors.New("movies.batch_limit must be at least 1"))
+	}
+	if c.Movies.IdempotencyWindow < 0 {
+		errs = append(errs, errors.New("movies.idempotency_window must not be negative"))
+	}
+	if c.Movies.PurgeAfterDays < 0 {
+		errs = append(errs, errors.New("movies.purge_after_days must not be negative"))
+	}
+	if c.Movies.EventRetention < 0 {
+		errs = append(errs, errors.New("movies.event_retention must not be negative"))
+	}
+	if (c.Movies.PurgeAfterDays > 0 || c.Movies.EventRetention > 0) && c.Movies.PurgeInterval <= 0 {
+		errs = append(errs, errors.New("movies.purge_interval must be positive when purging or event pruning is enabled"))
+	}
+	if c.Movies.WatchPollInterval <= 0 {
+		errs = append(errs, errors.New("movies.watch_poll_interval must be positive"))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	return nil
+}
+
+// Validate reports every setting that the gateway cannot start with.
+func (c Client) Validate() error {
+	var tracing error
+	if c.Tracing.Endpoint == "" {
+		tracing = errors.New("tracing.endpoint is required")
+	}
+	err := errors.Join(
+		checkAddress("http_address", c.HTTPAddress),
+		checkAddress("server_address", c.ServerAddress),
+		tracing,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	return nil
+}
+
+func checkAddress(name, address string) error {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return fmt.Errorf("%s %q must be host:port: %w", name, address, err)
+	}
+	return nil
+}
+
+// String renders the config as YAML, in the form a config file takes, with
+// the Redis password redacted.
+func (c Server) String() string {
+	if c.Redis.Password != "" {
+		c.Redis.Password = redacted
+	}
+	return render(c)
+}
+
+func (c Client) String() string {
+	return render(c)
+}
+
+func render(cfg any) string {
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Sprintf("unprintable config: %v", err)
+	}
+	return string(out)
+}
@@ -1,10 +1,61 @@
 package dto
 
 import (
+	"time"
+
 	pb "github.com/renaldyhidayatt/movie_grpc/proto"
 )
 
 type MovieListResult struct {
 	Movies       []*pb.Movie
 	TotalRecords int64
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+type MovieListParams struct {
+	Page     int
+	PageSize int
+	Search   string
+	Genre    string
+	OrderBy  string
+	// PageToken continues a keyset listing and takes precedence over Page.
+	PageToken string
+	// IncludeTotal asks for TotalRecords, which costs a COUNT over the
+	// filtered rows.
+	IncludeTotal bool
+
+	// Zero values leave a filter unset. The rating bounds are pointers because
+	// 0 is a valid rating for unrated movies.
+	MinReleaseYear int32
+	MaxReleaseYear int32
+	CreatedAfter   time.Time
+	MinRating      *float64
+	MaxRating      *float64
+}
+
+// MovieImportResult is the outcome of importing one movie.
+type MovieImportResult struct {
+	Created bool
+	Err     error
+}
+
+// IdempotencyKey identifies a create request across its retries.
+type IdempotencyKey struct {
+	Key string
+	// RequestHash is a digest of the request. A key sent again with another
+	// hash is a different request, not a retry.
+	RequestHash string
+	// Since is the start of the window in which keys are remembered. Older
+	// keys are forgotten.
+	Since time.Time
+}
+
+// MovieDuplicate is a pair of movies that look like the same movie entered
+// twice. Movie is the older of the two.
+type MovieDuplicate struct {
+	Movie     *pb.Movie
+	Duplicate *pb.Movie
+	// Similarity is how alike the normalized titles are, from 0 to 1.
+	Similarity float64
 }
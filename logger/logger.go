@@ -13,6 +13,7 @@ type LoggerInterface interface {
 	Info(message string, fields ...zap.Field)
 	Fatal(message string, fields ...zap.Field)
 	Debug(message string, fields ...zap.Field)
+	Warn(message string, fields ...zap.Field)
 	Error(message string, fields ...zap.Field)
 }
 
@@ -85,6 +86,10 @@ func (l *Logger) Debug(message string, fields ...zap.Field) {
 	l.Log.Debug(message, fields...)
 }
 
+func (l *Logger) Warn(message string, fields ...zap.Field) {
+	l.Log.Warn(message, fields...)
+}
+
 func (l *Logger) Error(message string, fields ...zap.Field) {
 	l.Log.Error(message, fields...)
 }
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+type Credit struct {
+	ID            string `gorm:"primarykey"`
+	MovieID       string `gorm:"index"`
+	PersonID      string `gorm:"index"`
+	Role          string
+	CharacterName string
+	BillingOrder  int32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Movie         Movie
+	Person        Person
+}
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+type Genre struct {
+	ID        string `gorm:"primarykey"`
+	Name      string
+	Slug      string `gorm:"uniqueIndex"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
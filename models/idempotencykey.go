@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// IdempotencyKey records the response to a CreateMovie call made with this
+// key, so a retry gets the same movie back instead of creating another.
+type IdempotencyKey struct {
+	Key string `gorm:"primaryKey"`
+	// RequestHash is a digest of the request, which tells a retry from a
+	// different request reusing the key.
+	RequestHash string
+	// Response is the created movie in protobuf wire format.
+	Response  []byte
+	CreatedAt time.Time `gorm:"index"`
+}
@@ -2,12 +2,28 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Movie struct {
-	ID        string `gorm:"primarykey"`
-	Title     string
-	Genre     string
-	CreatedAt time.Time `gorm:"autoCreateTime:false"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime:false"`
+	ID               string `gorm:"primarykey"`
+	Title            string
+	ReleaseYear      int32
+	RuntimeMinutes   int32
+	Synopsis         string
+	OriginalLanguage string
+	AgeRating        string
+	PosterURL        string
+	AverageRating    float64
+	ReviewCount      int64
+	Version          int64 `gorm:"default:1"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+	Genres           []Genre        `gorm:"many2many:movie_genres"`
+	// NormalizedTitle is Title folded by repository.NormalizeTitle. Together
+	// with ReleaseYear it is unique among live movies. It is NULL only for
+	// movies that already duplicated another when the constraint was added.
+	NormalizedTitle *string
 }
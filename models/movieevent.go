@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+// MovieEvent is a row of the movie change outbox. It is written in the same
+// transaction as the change it records.
+type MovieEvent struct {
+	Revision  int64  `gorm:"primaryKey;autoIncrement"`
+	MovieID   string `gorm:"index"`
+	Type      string
+	CreatedAt time.Time
+}
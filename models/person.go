@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+type Person struct {
+	ID        string `gorm:"primarykey"`
+	Name      string
+	Biography string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
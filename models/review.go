@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+type Review struct {
+	ID        string `gorm:"primarykey"`
+	MovieID   string `gorm:"index"`
+	Author    string
+	Score     int32
+	Text      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
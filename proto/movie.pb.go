@@ -9,6 +9,8 @@ package movie_grpc
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,30 +23,3747 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AgeRating int32
+
+const (
+	AgeRating_AGE_RATING_UNSPECIFIED AgeRating = 0
+	AgeRating_AGE_RATING_G           AgeRating = 1
+	AgeRating_AGE_RATING_PG          AgeRating = 2
+	AgeRating_AGE_RATING_PG_13       AgeRating = 3
+	AgeRating_AGE_RATING_R           AgeRating = 4
+	AgeRating_AGE_RATING_NC_17       AgeRating = 5
+)
+
+// Enum value maps for AgeRating.
+var (
+	AgeRating_name = map[int32]string{
+		0: "AGE_RATING_UNSPECIFIED",
+		1: "AGE_RATING_G",
+		2: "AGE_RATING_PG",
+		3: "AGE_RATING_PG_13",
+		4: "AGE_RATING_R",
+		5: "AGE_RATING_NC_17",
+	}
+	AgeRating_value = map[string]int32{
+		"AGE_RATING_UNSPECIFIED": 0,
+		"AGE_RATING_G":           1,
+		"AGE_RATING_PG":          2,
+		"AGE_RATING_PG_13":       3,
+		"AGE_RATING_R":           4,
+		"AGE_RATING_NC_17":       5,
+	}
+)
+
+func (x AgeRating) Enum() *AgeRating {
+	p := new(AgeRating)
+	*p = x
+	return p
+}
+
+func (x AgeRating) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgeRating) Descriptor() protoreflect.EnumDescriptor {
+	return file_movie_proto_enumTypes[0].Descriptor()
+}
+
+func (AgeRating) Type() protoreflect.EnumType {
+	return &file_movie_proto_enumTypes[0]
+}
+
+func (x AgeRating) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgeRating.Descriptor instead.
+func (AgeRating) EnumDescriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{0}
+}
+
+type CreditRole int32
+
+const (
+	CreditRole_CREDIT_ROLE_UNSPECIFIED CreditRole = 0
+	CreditRole_CREDIT_ROLE_DIRECTOR    CreditRole = 1
+	CreditRole_CREDIT_ROLE_WRITER      CreditRole = 2
+	CreditRole_CREDIT_ROLE_PRODUCER    CreditRole = 3
+	CreditRole_CREDIT_ROLE_CAST        CreditRole = 4
+	CreditRole_CREDIT_ROLE_CREW        CreditRole = 5
+)
+
+// Enum value maps for CreditRole.
+var (
+	CreditRole_name = map[int32]string{
+		0: "CREDIT_ROLE_UNSPECIFIED",
+		1: "CREDIT_ROLE_DIRECTOR",
+		2: "CREDIT_ROLE_WRITER",
+		3: "CREDIT_ROLE_PRODUCER",
+		4: "CREDIT_ROLE_CAST",
+		5: "CREDIT_ROLE_CREW",
+	}
+	CreditRole_value = map[string]int32{
+		"CREDIT_ROLE_UNSPECIFIED": 0,
+		"CREDIT_ROLE_DIRECTOR":    1,
+		"CREDIT_ROLE_WRITER":      2,
+		"CREDIT_ROLE_PRODUCER":    3,
+		"CREDIT_ROLE_CAST":        4,
+		"CREDIT_ROLE_CREW":        5,
+	}
+)
+
+func (x CreditRole) Enum() *CreditRole {
+	p := new(CreditRole)
+	*p = x
+	return p
+}
+
+func (x CreditRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_movie_proto_enumTypes[1].Descriptor()
+}
+
+func (CreditRole) Type() protoreflect.EnumType {
+	return &file_movie_proto_enumTypes[1]
+}
+
+func (x CreditRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditRole.Descriptor instead.
+func (CreditRole) EnumDescriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{1}
+}
+
+type MovieEventType int32
+
+const (
+	MovieEventType_MOVIE_EVENT_TYPE_UNSPECIFIED MovieEventType = 0
+	// CREATED is also sent when a deleted movie is restored.
+	MovieEventType_MOVIE_EVENT_TYPE_CREATED MovieEventType = 1
+	MovieEventType_MOVIE_EVENT_TYPE_UPDATED MovieEventType = 2
+	MovieEventType_MOVIE_EVENT_TYPE_DELETED MovieEventType = 3
+)
+
+// Enum value maps for MovieEventType.
+var (
+	MovieEventType_name = map[int32]string{
+		0: "MOVIE_EVENT_TYPE_UNSPECIFIED",
+		1: "MOVIE_EVENT_TYPE_CREATED",
+		2: "MOVIE_EVENT_TYPE_UPDATED",
+		3: "MOVIE_EVENT_TYPE_DELETED",
+	}
+	MovieEventType_value = map[string]int32{
+		"MOVIE_EVENT_TYPE_UNSPECIFIED": 0,
+		"MOVIE_EVENT_TYPE_CREATED":     1,
+		"MOVIE_EVENT_TYPE_UPDATED":     2,
+		"MOVIE_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x MovieEventType) Enum() *MovieEventType {
+	p := new(MovieEventType)
+	*p = x
+	return p
+}
+
+func (x MovieEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MovieEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_movie_proto_enumTypes[2].Descriptor()
+}
+
+func (MovieEventType) Type() protoreflect.EnumType {
+	return &file_movie_proto_enumTypes[2]
+}
+
+func (x MovieEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MovieEventType.Descriptor instead.
+func (MovieEventType) EnumDescriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{2}
+}
+
 type Movie struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title and release_year are unique among live movies, ignoring case and
+	// punctuation in the title. Writes that would repeat them fail with
+	// ALREADY_EXISTS naming the existing movie.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// genre is the comma-separated form of genres, kept for older clients.
+	// It is only read on writes that leave genres empty.
+	Genre            string                 `protobuf:"bytes,3,opt,name=genre,proto3" json:"genre,omitempty"`
+	ReleaseYear      int32                  `protobuf:"varint,4,opt,name=release_year,json=releaseYear,proto3" json:"release_year,omitempty"`
+	RuntimeMinutes   int32                  `protobuf:"varint,5,opt,name=runtime_minutes,json=runtimeMinutes,proto3" json:"runtime_minutes,omitempty"`
+	Synopsis         string                 `protobuf:"bytes,6,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	OriginalLanguage string                 `protobuf:"bytes,7,opt,name=original_language,json=originalLanguage,proto3" json:"original_language,omitempty"`
+	AgeRating        AgeRating              `protobuf:"varint,8,opt,name=age_rating,json=ageRating,proto3,enum=proto.AgeRating" json:"age_rating,omitempty"`
+	PosterUrl        string                 `protobuf:"bytes,9,opt,name=poster_url,json=posterUrl,proto3" json:"poster_url,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Genres           []string               `protobuf:"bytes,12,rep,name=genres,proto3" json:"genres,omitempty"`
+	// average_rating and review_count are maintained from reviews and are
+	// ignored on writes.
+	AverageRating float64 `protobuf:"fixed64,13,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	ReviewCount   int64   `protobuf:"varint,14,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	// version starts at 1 and goes up by one with every update. It is
+	// ignored on writes; see expected_version instead.
+	Version int64 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at is only set on movies returned by ListDeletedMovies.
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Movie) Reset() {
+	*x = Movie{}
+	mi := &file_movie_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Movie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Movie) ProtoMessage() {}
+
+func (x *Movie) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
+func (*Movie) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Movie) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Movie) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Movie) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *Movie) GetReleaseYear() int32 {
+	if x != nil {
+		return x.ReleaseYear
+	}
+	return 0
+}
+
+func (x *Movie) GetRuntimeMinutes() int32 {
+	if x != nil {
+		return x.RuntimeMinutes
+	}
+	return 0
+}
+
+func (x *Movie) GetSynopsis() string {
+	if x != nil {
+		return x.Synopsis
+	}
+	return ""
+}
+
+func (x *Movie) GetOriginalLanguage() string {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return ""
+}
+
+func (x *Movie) GetAgeRating() AgeRating {
+	if x != nil {
+		return x.AgeRating
+	}
+	return AgeRating_AGE_RATING_UNSPECIFIED
+}
+
+func (x *Movie) GetPosterUrl() string {
+	if x != nil {
+		return x.PosterUrl
+	}
+	return ""
+}
+
+func (x *Movie) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Movie) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Movie) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Movie) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *Movie) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *Movie) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Movie) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Review struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId string                 `protobuf:"bytes,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Author  string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// score is between 1 and 10 inclusive.
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_movie_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *Review) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Review) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Review) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Person struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Genre         string                 `protobuf:"bytes,3,opt,name=genre,proto3" json:"genre,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Biography     string                 `protobuf:"bytes,3,opt,name=biography,proto3" json:"biography,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_movie_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Person) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetBiography() string {
+	if x != nil {
+		return x.Biography
+	}
+	return ""
+}
+
+func (x *Person) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Person) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Credit struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId  string                 `protobuf:"bytes,2,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	PersonId string                 `protobuf:"bytes,3,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
+	// person_name is filled in on reads and ignored on writes.
+	PersonName    string     `protobuf:"bytes,4,opt,name=person_name,json=personName,proto3" json:"person_name,omitempty"`
+	Role          CreditRole `protobuf:"varint,5,opt,name=role,proto3,enum=proto.CreditRole" json:"role,omitempty"`
+	CharacterName string     `protobuf:"bytes,6,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	BillingOrder  int32      `protobuf:"varint,7,opt,name=billing_order,json=billingOrder,proto3" json:"billing_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Credit) Reset() {
+	*x = Credit{}
+	mi := &file_movie_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credit) ProtoMessage() {}
+
+func (x *Credit) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credit.ProtoReflect.Descriptor instead.
+func (*Credit) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Credit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Credit) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *Credit) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *Credit) GetPersonName() string {
+	if x != nil {
+		return x.PersonName
+	}
+	return ""
+}
+
+func (x *Credit) GetRole() CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return CreditRole_CREDIT_ROLE_UNSPECIFIED
+}
+
+func (x *Credit) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *Credit) GetBillingOrder() int32 {
+	if x != nil {
+		return x.BillingOrder
+	}
+	return 0
+}
+
+type Genre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_movie_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Genre) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Genre) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CreateMovieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Movie *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	// request_id makes retries safe: a request with the id of an earlier one
+	// creates nothing and returns the movie the earlier one created. The
+	// idempotency-key metadata header does the same when request_id is empty.
+	RequestId     string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieRequest) Reset() {
+	*x = CreateMovieRequest{}
+	mi := &file_movie_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieRequest) ProtoMessage() {}
+
+func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieRequest.ProtoReflect.Descriptor instead.
+func (*CreateMovieRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMovieRequest) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *CreateMovieRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CreateMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieResponse) Reset() {
+	*x = CreateMovieResponse{}
+	mi := &file_movie_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieResponse) ProtoMessage() {}
+
+func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieResponse.ProtoReflect.Descriptor instead.
+func (*CreateMovieResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type ReadMovieRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeCredits bool                   `protobuf:"varint,2,opt,name=include_credits,json=includeCredits,proto3" json:"include_credits,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadMovieRequest) Reset() {
+	*x = ReadMovieRequest{}
+	mi := &file_movie_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMovieRequest) ProtoMessage() {}
+
+func (x *ReadMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMovieRequest.ProtoReflect.Descriptor instead.
+func (*ReadMovieRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadMovieRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReadMovieRequest) GetIncludeCredits() bool {
+	if x != nil {
+		return x.IncludeCredits
+	}
+	return false
+}
+
+type ReadMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Credits       []*Credit              `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadMovieResponse) Reset() {
+	*x = ReadMovieResponse{}
+	mi := &file_movie_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMovieResponse) ProtoMessage() {}
+
+func (x *ReadMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMovieResponse.ProtoReflect.Descriptor instead.
+func (*ReadMovieResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReadMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *ReadMovieResponse) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type ReadMoviesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search   string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	// genre matches movies tagged with exactly this genre after normalization,
+	// so "sci fi" and "Science Fiction" select the same movies.
+	Genre string `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	// order_by is a column optionally followed by "asc" or "desc", for example
+	// "rating desc". Supported columns: title, release_year, runtime_minutes,
+	// created_at, updated_at and rating.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// min_release_year and max_release_year bound release_year inclusively.
+	// Zero leaves that side of the range open.
+	MinReleaseYear int32 `protobuf:"varint,6,opt,name=min_release_year,json=minReleaseYear,proto3" json:"min_release_year,omitempty"`
+	MaxReleaseYear int32 `protobuf:"varint,7,opt,name=max_release_year,json=maxReleaseYear,proto3" json:"max_release_year,omitempty"`
+	// created_after keeps movies created strictly after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// min_rating and max_rating bound average_rating inclusively. Unrated
+	// movies have an average_rating of 0.
+	MinRating *float64 `protobuf:"fixed64,9,opt,name=min_rating,json=minRating,proto3,oneof" json:"min_rating,omitempty"`
+	MaxRating *float64 `protobuf:"fixed64,10,opt,name=max_rating,json=maxRating,proto3,oneof" json:"max_rating,omitempty"`
+	// page_token is the next_page_token of a previous response. When set, page
+	// is ignored and every other field must match the request that produced
+	// the token.
+	PageToken string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// include_total_count controls whether total_records is computed. It
+	// defaults to true for offset paging and to false when page_token is set.
+	IncludeTotalCount *bool `protobuf:"varint,12,opt,name=include_total_count,json=includeTotalCount,proto3,oneof" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReadMoviesRequest) Reset() {
+	*x = ReadMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMoviesRequest) ProtoMessage() {}
+
+func (x *ReadMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ReadMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadMoviesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadMoviesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadMoviesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ReadMoviesRequest) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *ReadMoviesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ReadMoviesRequest) GetMinReleaseYear() int32 {
+	if x != nil {
+		return x.MinReleaseYear
+	}
+	return 0
+}
+
+func (x *ReadMoviesRequest) GetMaxReleaseYear() int32 {
+	if x != nil {
+		return x.MaxReleaseYear
+	}
+	return 0
+}
+
+func (x *ReadMoviesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ReadMoviesRequest) GetMinRating() float64 {
+	if x != nil && x.MinRating != nil {
+		return *x.MinRating
+	}
+	return 0
+}
+
+func (x *ReadMoviesRequest) GetMaxRating() float64 {
+	if x != nil && x.MaxRating != nil {
+		return *x.MaxRating
+	}
+	return 0
+}
+
+func (x *ReadMoviesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadMoviesRequest) GetIncludeTotalCount() bool {
+	if x != nil && x.IncludeTotalCount != nil {
+		return *x.IncludeTotalCount
+	}
+	return false
+}
+
+type ReadMoviesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Movies []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	// total_records is zero when the count was not requested.
+	TotalRecords int64 `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	// next_page_token fetches the following page. It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadMoviesResponse) Reset() {
+	*x = ReadMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMoviesResponse) ProtoMessage() {}
+
+func (x *ReadMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMoviesResponse.ProtoReflect.Descriptor instead.
+func (*ReadMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadMoviesResponse) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *ReadMoviesResponse) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+func (x *ReadMoviesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is matched word by word against titles, genres and synopses. Every
+	// word must match, as a prefix, for a movie to be returned.
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMoviesRequest) Reset() {
+	*x = SearchMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMoviesRequest) ProtoMessage() {}
+
+func (x *SearchMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMoviesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchMoviesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMoviesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchMoviesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type MovieSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Movie *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	// score is the relevance of the match. Higher is better.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// title_highlight and snippet wrap matched words in <mark></mark>. snippet
+	// is an excerpt of the synopsis.
+	TitleHighlight string `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	Snippet        string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MovieSearchResult) Reset() {
+	*x = MovieSearchResult{}
+	mi := &file_movie_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieSearchResult) ProtoMessage() {}
+
+func (x *MovieSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieSearchResult.ProtoReflect.Descriptor instead.
+func (*MovieSearchResult) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MovieSearchResult) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *MovieSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MovieSearchResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *MovieSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MovieSearchResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalRecords  int64                  `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMoviesResponse) Reset() {
+	*x = SearchMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMoviesResponse) ProtoMessage() {}
+
+func (x *SearchMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMoviesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchMoviesResponse) GetResults() []*MovieSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMoviesResponse) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+type StreamMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// batch_size is how many movies are read from storage at a time. It bounds
+	// the server's memory use, not the stream length.
+	BatchSize     int32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMoviesRequest) Reset() {
+	*x = StreamMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMoviesRequest) ProtoMessage() {}
+
+func (x *StreamMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMoviesRequest.ProtoReflect.Descriptor instead.
+func (*StreamMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StreamMoviesRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ImportMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// movie is created, or updated when its id already exists.
+	Movie         *Movie `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMoviesRequest) Reset() {
+	*x = ImportMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMoviesRequest) ProtoMessage() {}
+
+func (x *ImportMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ImportMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportMoviesRequest) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type ImportError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the position of the failed movie in the request stream,
+	// starting at 0.
+	Index         int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_movie_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportError) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors        []*ImportError         `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMoviesResponse) Reset() {
+	*x = ImportMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMoviesResponse) ProtoMessage() {}
+
+func (x *ImportMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMoviesResponse.ProtoReflect.Descriptor instead.
+func (*ImportMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportMoviesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportMoviesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportMoviesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportMoviesResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// BatchError explains why one item of a batch failed.
+type BatchError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code is a google.rpc.Code value, as a single call would have returned.
+	Code          int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchError) Reset() {
+	*x = BatchError{}
+	mi := &file_movie_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchError) ProtoMessage() {}
+
+func (x *BatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchError.ProtoReflect.Descriptor instead.
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BatchMovieResult is the outcome for one item of a batch. Results come in
+// request order; exactly one of movie and error is set, except for deletes,
+// which leave both unset on success.
+type BatchMovieResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Movie         *Movie                 `protobuf:"bytes,2,opt,name=movie,proto3" json:"movie,omitempty"`
+	Error         *BatchError            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMovieResult) Reset() {
+	*x = BatchMovieResult{}
+	mi := &file_movie_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMovieResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMovieResult) ProtoMessage() {}
+
+func (x *BatchMovieResult) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMovieResult.ProtoReflect.Descriptor instead.
+func (*BatchMovieResult) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchMovieResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchMovieResult) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *BatchMovieResult) GetError() *BatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BatchGetMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetMoviesRequest) Reset() {
+	*x = BatchGetMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetMoviesRequest) ProtoMessage() {}
+
+func (x *BatchGetMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetMoviesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchGetMoviesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchMovieResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetMoviesResponse) Reset() {
+	*x = BatchGetMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetMoviesResponse) ProtoMessage() {}
+
+func (x *BatchGetMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetMoviesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchGetMoviesResponse) GetResults() []*BatchMovieResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchCreateMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateMoviesRequest) Reset() {
+	*x = BatchCreateMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateMoviesRequest) ProtoMessage() {}
+
+func (x *BatchCreateMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateMoviesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchCreateMoviesRequest) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+type BatchCreateMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchMovieResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateMoviesResponse) Reset() {
+	*x = BatchCreateMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateMoviesResponse) ProtoMessage() {}
+
+func (x *BatchCreateMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateMoviesResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchCreateMoviesResponse) GetResults() []*BatchMovieResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeleteMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteMoviesRequest) Reset() {
+	*x = BatchDeleteMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteMoviesRequest) ProtoMessage() {}
+
+func (x *BatchDeleteMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteMoviesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchDeleteMoviesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchDeleteMoviesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchMovieResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteMoviesResponse) Reset() {
+	*x = BatchDeleteMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteMoviesResponse) ProtoMessage() {}
+
+func (x *BatchDeleteMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteMoviesResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchDeleteMoviesResponse) GetResults() []*BatchMovieResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MovieEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revision increases with every change, so it orders the feed and marks a
+	// position to resume from.
+	Revision int64          `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type     MovieEventType `protobuf:"varint,2,opt,name=type,proto3,enum=proto.MovieEventType" json:"type,omitempty"`
+	MovieId  string         `protobuf:"bytes,3,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	// movie is the state of the movie when the event is delivered, which may
+	// already include later changes. It is unset for deleted movies.
+	Movie         *Movie                 `protobuf:"bytes,4,opt,name=movie,proto3" json:"movie,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieEvent) Reset() {
+	*x = MovieEvent{}
+	mi := &file_movie_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieEvent) ProtoMessage() {}
+
+func (x *MovieEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieEvent.ProtoReflect.Descriptor instead.
+func (*MovieEvent) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MovieEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *MovieEvent) GetType() MovieEventType {
+	if x != nil {
+		return x.Type
+	}
+	return MovieEventType_MOVIE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *MovieEvent) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *MovieEvent) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *MovieEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WatchMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// after_revision resumes the feed after the given revision; 0 replays every
+	// recorded event. When unset, only changes made from now on are sent.
+	// Events are kept for a limited time; resuming from a revision whose
+	// successors were pruned fails with OUT_OF_RANGE.
+	AfterRevision *int64 `protobuf:"varint,1,opt,name=after_revision,json=afterRevision,proto3,oneof" json:"after_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMoviesRequest) Reset() {
+	*x = WatchMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMoviesRequest) ProtoMessage() {}
+
+func (x *WatchMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMoviesRequest.ProtoReflect.Descriptor instead.
+func (*WatchMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchMoviesRequest) GetAfterRevision() int64 {
+	if x != nil && x.AfterRevision != nil {
+		return *x.AfterRevision
+	}
+	return 0
+}
+
+type UpdateMovieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Movie *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	// update_mask lists the fields to write, such as "title" or "genres".
+	// Listed fields are set to the value in movie even when it is empty, which
+	// clears them. Without a mask only the non-empty fields of movie are
+	// written.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// expected_version makes the update fail with ABORTED unless
+	// the stored movie still has this version.
+	ExpectedVersion *int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateMovieRequest) Reset() {
+	*x = UpdateMovieRequest{}
+	mi := &file_movie_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMovieRequest) ProtoMessage() {}
+
+func (x *UpdateMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMovieRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMovieRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateMovieRequest) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *UpdateMovieRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMovieRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMovieResponse) Reset() {
+	*x = UpdateMovieResponse{}
+	mi := &file_movie_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMovieResponse) ProtoMessage() {}
+
+func (x *UpdateMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMovieResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMovieResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type DeleteMovieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected_version makes the delete fail with ABORTED unless
+	// the stored movie still has this version.
+	ExpectedVersion *int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteMovieRequest) Reset() {
+	*x = DeleteMovieRequest{}
+	mi := &file_movie_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieRequest) ProtoMessage() {}
+
+func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteMovieRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMovieRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieResponse) Reset() {
+	*x = DeleteMovieResponse{}
+	mi := &file_movie_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieResponse) ProtoMessage() {}
+
+func (x *DeleteMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMovieResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteMovieResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UndeleteMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteMovieRequest) Reset() {
+	*x = UndeleteMovieRequest{}
+	mi := &file_movie_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteMovieRequest) ProtoMessage() {}
+
+func (x *UndeleteMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteMovieRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteMovieRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UndeleteMovieRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UndeleteMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteMovieResponse) Reset() {
+	*x = UndeleteMovieResponse{}
+	mi := &file_movie_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteMovieResponse) ProtoMessage() {}
+
+func (x *UndeleteMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteMovieResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteMovieResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UndeleteMovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+type ListDeletedMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedMoviesRequest) Reset() {
+	*x = ListDeletedMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedMoviesRequest) ProtoMessage() {}
+
+func (x *ListDeletedMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListDeletedMoviesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedMoviesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedMoviesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// movies are ordered most recently deleted first.
+	Movies        []*Movie `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	TotalRecords  int64    `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedMoviesResponse) Reset() {
+	*x = ListDeletedMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedMoviesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedMoviesResponse) ProtoMessage() {}
+
+func (x *ListDeletedMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedMoviesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListDeletedMoviesResponse) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *ListDeletedMoviesResponse) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+type PurgeMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeMovieRequest) Reset() {
+	*x = PurgeMovieRequest{}
+	mi := &file_movie_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeMovieRequest) ProtoMessage() {}
+
+func (x *PurgeMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeMovieRequest.ProtoReflect.Descriptor instead.
+func (*PurgeMovieRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PurgeMovieRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeMovieResponse) Reset() {
+	*x = PurgeMovieResponse{}
+	mi := &file_movie_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeMovieResponse) ProtoMessage() {}
+
+func (x *PurgeMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeMovieResponse.ProtoReflect.Descriptor instead.
+func (*PurgeMovieResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PurgeMovieResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type FindDuplicatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// min_similarity is how alike two normalized titles must be, from 0 to
+	// 1, for the movies to be reported. Zero means 0.85.
+	MinSimilarity float64 `protobuf:"fixed64,1,opt,name=min_similarity,json=minSimilarity,proto3" json:"min_similarity,omitempty"`
+	// limit caps the number of pairs returned. Zero means 100.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_movie_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FindDuplicatesRequest) GetMinSimilarity() float64 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+func (x *FindDuplicatesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// DuplicateMovies is two live movies that look like one movie entered twice.
+// Their release years are equal, or unknown for at least one of them.
+type DuplicateMovies struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// movie is the older of the two.
+	Movie     *Movie `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Duplicate *Movie `protobuf:"bytes,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// similarity is 1 when the titles are the same after normalization.
+	Similarity    float64 `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMovies) Reset() {
+	*x = DuplicateMovies{}
+	mi := &file_movie_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMovies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMovies) ProtoMessage() {}
+
+func (x *DuplicateMovies) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMovies.ProtoReflect.Descriptor instead.
+func (*DuplicateMovies) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DuplicateMovies) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *DuplicateMovies) GetDuplicate() *Movie {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+func (x *DuplicateMovies) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type FindDuplicatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// duplicates are ordered most alike first.
+	Duplicates    []*DuplicateMovies `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_movie_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FindDuplicatesResponse) GetDuplicates() []*DuplicateMovies {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+type CreateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreRequest) Reset() {
+	*x = CreateGenreRequest{}
+	mi := &file_movie_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreRequest) ProtoMessage() {}
+
+func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
+func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateGenreRequest) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type CreateGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreResponse) Reset() {
+	*x = CreateGenreResponse{}
+	mi := &file_movie_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreResponse) ProtoMessage() {}
+
+func (x *CreateGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreResponse.ProtoReflect.Descriptor instead.
+func (*CreateGenreResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateGenreResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type ReadGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadGenreRequest) Reset() {
+	*x = ReadGenreRequest{}
+	mi := &file_movie_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadGenreRequest) ProtoMessage() {}
+
+func (x *ReadGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadGenreRequest.ProtoReflect.Descriptor instead.
+func (*ReadGenreRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReadGenreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReadGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadGenreResponse) Reset() {
+	*x = ReadGenreResponse{}
+	mi := &file_movie_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadGenreResponse) ProtoMessage() {}
+
+func (x *ReadGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadGenreResponse.ProtoReflect.Descriptor instead.
+func (*ReadGenreResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReadGenreResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type ReadGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadGenresRequest) Reset() {
+	*x = ReadGenresRequest{}
+	mi := &file_movie_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadGenresRequest) ProtoMessage() {}
+
+func (x *ReadGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadGenresRequest.ProtoReflect.Descriptor instead.
+func (*ReadGenresRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{45}
+}
+
+type ReadGenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*Genre               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadGenresResponse) Reset() {
+	*x = ReadGenresResponse{}
+	mi := &file_movie_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadGenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadGenresResponse) ProtoMessage() {}
+
+func (x *ReadGenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadGenresResponse.ProtoReflect.Descriptor instead.
+func (*ReadGenresResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReadGenresResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type UpdateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenreRequest) Reset() {
+	*x = UpdateGenreRequest{}
+	mi := &file_movie_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenreRequest) ProtoMessage() {}
+
+func (x *UpdateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateGenreRequest) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type UpdateGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenreResponse) Reset() {
+	*x = UpdateGenreResponse{}
+	mi := &file_movie_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenreResponse) ProtoMessage() {}
+
+func (x *UpdateGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenreResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGenreResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateGenreResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type DeleteGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreRequest) Reset() {
+	*x = DeleteGenreRequest{}
+	mi := &file_movie_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreRequest) ProtoMessage() {}
+
+func (x *DeleteGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGenreRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteGenreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreResponse) Reset() {
+	*x = DeleteGenreResponse{}
+	mi := &file_movie_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreResponse) ProtoMessage() {}
+
+func (x *DeleteGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGenreResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteGenreResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreatePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonRequest) Reset() {
+	*x = CreatePersonRequest{}
+	mi := &file_movie_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonRequest) ProtoMessage() {}
+
+func (x *CreatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreatePersonRequest) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type CreatePersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonResponse) Reset() {
+	*x = CreatePersonResponse{}
+	mi := &file_movie_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonResponse) ProtoMessage() {}
+
+func (x *CreatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonResponse.ProtoReflect.Descriptor instead.
+func (*CreatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreatePersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type ReadPersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadPersonRequest) Reset() {
+	*x = ReadPersonRequest{}
+	mi := &file_movie_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPersonRequest) ProtoMessage() {}
+
+func (x *ReadPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPersonRequest.ProtoReflect.Descriptor instead.
+func (*ReadPersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReadPersonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReadPersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadPersonResponse) Reset() {
+	*x = ReadPersonResponse{}
+	mi := &file_movie_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPersonResponse) ProtoMessage() {}
+
+func (x *ReadPersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPersonResponse.ProtoReflect.Descriptor instead.
+func (*ReadPersonResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReadPersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type ReadPeopleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadPeopleRequest) Reset() {
+	*x = ReadPeopleRequest{}
+	mi := &file_movie_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPeopleRequest) ProtoMessage() {}
+
+func (x *ReadPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPeopleRequest.ProtoReflect.Descriptor instead.
+func (*ReadPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReadPeopleRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadPeopleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadPeopleRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ReadPeopleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	People        []*Person              `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"`
+	TotalRecords  int64                  `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadPeopleResponse) Reset() {
+	*x = ReadPeopleResponse{}
+	mi := &file_movie_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPeopleResponse) ProtoMessage() {}
+
+func (x *ReadPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPeopleResponse.ProtoReflect.Descriptor instead.
+func (*ReadPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReadPeopleResponse) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+func (x *ReadPeopleResponse) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+type UpdatePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePersonRequest) Reset() {
+	*x = UpdatePersonRequest{}
+	mi := &file_movie_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonRequest) ProtoMessage() {}
+
+func (x *UpdatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdatePersonRequest) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type UpdatePersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePersonResponse) Reset() {
+	*x = UpdatePersonResponse{}
+	mi := &file_movie_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonResponse) ProtoMessage() {}
+
+func (x *UpdatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdatePersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type DeletePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePersonRequest) Reset() {
+	*x = DeletePersonRequest{}
+	mi := &file_movie_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonRequest) ProtoMessage() {}
+
+func (x *DeletePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonRequest.ProtoReflect.Descriptor instead.
+func (*DeletePersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeletePersonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePersonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePersonResponse) Reset() {
+	*x = DeletePersonResponse{}
+	mi := &file_movie_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonResponse) ProtoMessage() {}
+
+func (x *DeletePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonResponse.ProtoReflect.Descriptor instead.
+func (*DeletePersonResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeletePersonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credit        *Credit                `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCreditRequest) Reset() {
+	*x = CreateCreditRequest{}
+	mi := &file_movie_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditRequest) ProtoMessage() {}
+
+func (x *CreateCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditRequest.ProtoReflect.Descriptor instead.
+func (*CreateCreditRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateCreditRequest) GetCredit() *Credit {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+type CreateCreditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credit        *Credit                `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCreditResponse) Reset() {
+	*x = CreateCreditResponse{}
+	mi := &file_movie_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditResponse) ProtoMessage() {}
+
+func (x *CreateCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditResponse.ProtoReflect.Descriptor instead.
+func (*CreateCreditResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateCreditResponse) GetCredit() *Credit {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+type DeleteCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCreditRequest) Reset() {
+	*x = DeleteCreditRequest{}
+	mi := &file_movie_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCreditRequest) ProtoMessage() {}
+
+func (x *DeleteCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCreditRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCreditRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteCreditRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCreditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCreditResponse) Reset() {
+	*x = DeleteCreditResponse{}
+	mi := &file_movie_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCreditResponse) ProtoMessage() {}
+
+func (x *DeleteCreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCreditResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCreditResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteCreditResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReadPersonMoviesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PersonId string                 `protobuf:"bytes,1,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
+	// role narrows the result to one kind of credit when set.
+	Role          CreditRole `protobuf:"varint,2,opt,name=role,proto3,enum=proto.CreditRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Movie) Reset() {
-	*x = Movie{}
-	mi := &file_movie_proto_msgTypes[0]
+func (x *ReadPersonMoviesRequest) Reset() {
+	*x = ReadPersonMoviesRequest{}
+	mi := &file_movie_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Movie) String() string {
+func (x *ReadPersonMoviesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Movie) ProtoMessage() {}
+func (*ReadPersonMoviesRequest) ProtoMessage() {}
 
-func (x *Movie) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[0]
+func (x *ReadPersonMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,54 +3774,48 @@ func (x *Movie) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
-func (*Movie) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Movie) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ReadPersonMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ReadPersonMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Movie) GetTitle() string {
+func (x *ReadPersonMoviesRequest) GetPersonId() string {
 	if x != nil {
-		return x.Title
+		return x.PersonId
 	}
 	return ""
 }
 
-func (x *Movie) GetGenre() string {
+func (x *ReadPersonMoviesRequest) GetRole() CreditRole {
 	if x != nil {
-		return x.Genre
+		return x.Role
 	}
-	return ""
+	return CreditRole_CREDIT_ROLE_UNSPECIFIED
 }
 
-type CreateMovieRequest struct {
+type PersonMovie struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Credit        *Credit                `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMovieRequest) Reset() {
-	*x = CreateMovieRequest{}
-	mi := &file_movie_proto_msgTypes[1]
+func (x *PersonMovie) Reset() {
+	*x = PersonMovie{}
+	mi := &file_movie_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMovieRequest) String() string {
+func (x *PersonMovie) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMovieRequest) ProtoMessage() {}
+func (*PersonMovie) ProtoMessage() {}
 
-func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[1]
+func (x *PersonMovie) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,40 +3826,47 @@ func (x *CreateMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMovieRequest.ProtoReflect.Descriptor instead.
-func (*CreateMovieRequest) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PersonMovie.ProtoReflect.Descriptor instead.
+func (*PersonMovie) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateMovieRequest) GetMovie() *Movie {
+func (x *PersonMovie) GetMovie() *Movie {
 	if x != nil {
 		return x.Movie
 	}
 	return nil
 }
 
-type CreateMovieResponse struct {
+func (x *PersonMovie) GetCredit() *Credit {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+type ReadPersonMoviesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Movies        []*PersonMovie         `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMovieResponse) Reset() {
-	*x = CreateMovieResponse{}
-	mi := &file_movie_proto_msgTypes[2]
+func (x *ReadPersonMoviesResponse) Reset() {
+	*x = ReadPersonMoviesResponse{}
+	mi := &file_movie_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMovieResponse) String() string {
+func (x *ReadPersonMoviesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMovieResponse) ProtoMessage() {}
+func (*ReadPersonMoviesResponse) ProtoMessage() {}
 
-func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[2]
+func (x *ReadPersonMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,40 +3877,40 @@ func (x *CreateMovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMovieResponse.ProtoReflect.Descriptor instead.
-func (*CreateMovieResponse) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ReadPersonMoviesResponse.ProtoReflect.Descriptor instead.
+func (*ReadPersonMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreateMovieResponse) GetMovie() *Movie {
+func (x *ReadPersonMoviesResponse) GetMovies() []*PersonMovie {
 	if x != nil {
-		return x.Movie
+		return x.Movies
 	}
 	return nil
 }
 
-type ReadMovieRequest struct {
+type CreateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadMovieRequest) Reset() {
-	*x = ReadMovieRequest{}
-	mi := &file_movie_proto_msgTypes[3]
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_movie_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadMovieRequest) String() string {
+func (x *CreateReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMovieRequest) ProtoMessage() {}
+func (*CreateReviewRequest) ProtoMessage() {}
 
-func (x *ReadMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[3]
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,40 +3921,40 @@ func (x *ReadMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMovieRequest.ProtoReflect.Descriptor instead.
-func (*ReadMovieRequest) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ReadMovieRequest) GetId() string {
+func (x *CreateReviewRequest) GetReview() *Review {
 	if x != nil {
-		return x.Id
+		return x.Review
 	}
-	return ""
+	return nil
 }
 
-type ReadMovieResponse struct {
+type CreateReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadMovieResponse) Reset() {
-	*x = ReadMovieResponse{}
-	mi := &file_movie_proto_msgTypes[4]
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_movie_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadMovieResponse) String() string {
+func (x *CreateReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMovieResponse) ProtoMessage() {}
+func (*CreateReviewResponse) ProtoMessage() {}
 
-func (x *ReadMovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[4]
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,42 +3965,40 @@ func (x *ReadMovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMovieResponse.ProtoReflect.Descriptor instead.
-func (*ReadMovieResponse) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ReadMovieResponse) GetMovie() *Movie {
+func (x *CreateReviewResponse) GetReview() *Review {
 	if x != nil {
-		return x.Movie
+		return x.Review
 	}
 	return nil
 }
 
-type ReadMoviesRequest struct {
+type UpdateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadMoviesRequest) Reset() {
-	*x = ReadMoviesRequest{}
-	mi := &file_movie_proto_msgTypes[5]
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_movie_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadMoviesRequest) String() string {
+func (x *UpdateReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMoviesRequest) ProtoMessage() {}
+func (*UpdateReviewRequest) ProtoMessage() {}
 
-func (x *ReadMoviesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[5]
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,55 +4009,40 @@ func (x *ReadMoviesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMoviesRequest.ProtoReflect.Descriptor instead.
-func (*ReadMoviesRequest) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ReadMoviesRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ReadMoviesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ReadMoviesRequest) GetSearch() string {
+func (x *UpdateReviewRequest) GetReview() *Review {
 	if x != nil {
-		return x.Search
+		return x.Review
 	}
-	return ""
+	return nil
 }
 
-type ReadMoviesResponse struct {
+type UpdateReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
-	TotalRecords  int64                  `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadMoviesResponse) Reset() {
-	*x = ReadMoviesResponse{}
-	mi := &file_movie_proto_msgTypes[6]
+func (x *UpdateReviewResponse) Reset() {
+	*x = UpdateReviewResponse{}
+	mi := &file_movie_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadMoviesResponse) String() string {
+func (x *UpdateReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMoviesResponse) ProtoMessage() {}
+func (*UpdateReviewResponse) ProtoMessage() {}
 
-func (x *ReadMoviesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[6]
+func (x *UpdateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,47 +4053,40 @@ func (x *ReadMoviesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMoviesResponse.ProtoReflect.Descriptor instead.
-func (*ReadMoviesResponse) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateReviewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ReadMoviesResponse) GetMovies() []*Movie {
+func (x *UpdateReviewResponse) GetReview() *Review {
 	if x != nil {
-		return x.Movies
+		return x.Review
 	}
 	return nil
 }
 
-func (x *ReadMoviesResponse) GetTotalRecords() int64 {
-	if x != nil {
-		return x.TotalRecords
-	}
-	return 0
-}
-
-type UpdateMovieRequest struct {
+type DeleteReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMovieRequest) Reset() {
-	*x = UpdateMovieRequest{}
-	mi := &file_movie_proto_msgTypes[7]
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_movie_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMovieRequest) String() string {
+func (x *DeleteReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMovieRequest) ProtoMessage() {}
+func (*DeleteReviewRequest) ProtoMessage() {}
 
-func (x *UpdateMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[7]
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,40 +4097,40 @@ func (x *UpdateMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMovieRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMovieRequest) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateMovieRequest) GetMovie() *Movie {
+func (x *DeleteReviewRequest) GetId() string {
 	if x != nil {
-		return x.Movie
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdateMovieResponse struct {
+type DeleteReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movie         *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMovieResponse) Reset() {
-	*x = UpdateMovieResponse{}
-	mi := &file_movie_proto_msgTypes[8]
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_movie_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMovieResponse) String() string {
+func (x *DeleteReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMovieResponse) ProtoMessage() {}
+func (*DeleteReviewResponse) ProtoMessage() {}
 
-func (x *UpdateMovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[8]
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,40 +4141,42 @@ func (x *UpdateMovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMovieResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMovieResponse) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateMovieResponse) GetMovie() *Movie {
+func (x *DeleteReviewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Movie
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type DeleteMovieRequest struct {
+type ReadReviewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieId       string                 `protobuf:"bytes,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMovieRequest) Reset() {
-	*x = DeleteMovieRequest{}
-	mi := &file_movie_proto_msgTypes[9]
+func (x *ReadReviewsRequest) Reset() {
+	*x = ReadReviewsRequest{}
+	mi := &file_movie_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMovieRequest) String() string {
+func (x *ReadReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMovieRequest) ProtoMessage() {}
+func (*ReadReviewsRequest) ProtoMessage() {}
 
-func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[9]
+func (x *ReadReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,40 +4187,55 @@ func (x *DeleteMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMovieRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMovieRequest) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReadReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ReadReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DeleteMovieRequest) GetId() string {
+func (x *ReadReviewsRequest) GetMovieId() string {
 	if x != nil {
-		return x.Id
+		return x.MovieId
 	}
 	return ""
 }
 
-type DeleteMovieResponse struct {
+func (x *ReadReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ReadReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ReadReviewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	TotalRecords  int64                  `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMovieResponse) Reset() {
-	*x = DeleteMovieResponse{}
-	mi := &file_movie_proto_msgTypes[10]
+func (x *ReadReviewsResponse) Reset() {
+	*x = ReadReviewsResponse{}
+	mi := &file_movie_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMovieResponse) String() string {
+func (x *ReadReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMovieResponse) ProtoMessage() {}
+func (*ReadReviewsResponse) ProtoMessage() {}
 
-func (x *DeleteMovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[10]
+func (x *ReadReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,56 +4246,353 @@ func (x *DeleteMovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMovieResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMovieResponse) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReadReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ReadReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *DeleteMovieResponse) GetSuccess() bool {
+func (x *ReadReviewsResponse) GetReviews() []*Review {
 	if x != nil {
-		return x.Success
+		return x.Reviews
 	}
-	return false
+	return nil
+}
+
+func (x *ReadReviewsResponse) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
 }
 
 var File_movie_proto protoreflect.FileDescriptor
 
 const file_movie_proto_rawDesc = "" +
 	"\n" +
-	"\vmovie.proto\x12\x05proto\"C\n" +
+	"\vmovie.proto\x12\x05proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x04\n" +
 	"\x05Movie\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
-	"\x05genre\x18\x03 \x01(\tR\x05genre\"8\n" +
+	"\x05genre\x18\x03 \x01(\tR\x05genre\x12!\n" +
+	"\frelease_year\x18\x04 \x01(\x05R\vreleaseYear\x12'\n" +
+	"\x0fruntime_minutes\x18\x05 \x01(\x05R\x0eruntimeMinutes\x12\x1a\n" +
+	"\bsynopsis\x18\x06 \x01(\tR\bsynopsis\x12+\n" +
+	"\x11original_language\x18\a \x01(\tR\x10originalLanguage\x12/\n" +
+	"\n" +
+	"age_rating\x18\b \x01(\x0e2\x10.proto.AgeRatingR\tageRating\x12\x1d\n" +
+	"\n" +
+	"poster_url\x18\t \x01(\tR\tposterUrl\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06genres\x18\f \x03(\tR\x06genres\x12%\n" +
+	"\x0eaverage_rating\x18\r \x01(\x01R\raverageRating\x12!\n" +
+	"\freview_count\x18\x0e \x01(\x03R\vreviewCount\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xeb\x01\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmovie_id\x18\x02 \x01(\tR\amovieId\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc0\x01\n" +
+	"\x06Person\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tbiography\x18\x03 \x01(\tR\tbiography\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x01\n" +
+	"\x06Credit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmovie_id\x18\x02 \x01(\tR\amovieId\x12\x1b\n" +
+	"\tperson_id\x18\x03 \x01(\tR\bpersonId\x12\x1f\n" +
+	"\vperson_name\x18\x04 \x01(\tR\n" +
+	"personName\x12%\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x11.proto.CreditRoleR\x04role\x12%\n" +
+	"\x0echaracter_name\x18\x06 \x01(\tR\rcharacterName\x12#\n" +
+	"\rbilling_order\x18\a \x01(\x05R\fbillingOrder\"?\n" +
+	"\x05Genre\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\"W\n" +
 	"\x12CreateMovieRequest\x12\"\n" +
-	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"9\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"9\n" +
 	"\x13CreateMovieResponse\x12\"\n" +
-	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"K\n" +
 	"\x10ReadMovieRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_credits\x18\x02 \x01(\bR\x0eincludeCredits\"`\n" +
 	"\x11ReadMovieResponse\x12\"\n" +
-	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"\\\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\x12'\n" +
+	"\acredits\x18\x02 \x03(\v2\r.proto.CreditR\acredits\"\xf4\x03\n" +
 	"\x11ReadMoviesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06search\x18\x03 \x01(\tR\x06search\"_\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x14\n" +
+	"\x05genre\x18\x04 \x01(\tR\x05genre\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x12(\n" +
+	"\x10min_release_year\x18\x06 \x01(\x05R\x0eminReleaseYear\x12(\n" +
+	"\x10max_release_year\x18\a \x01(\x05R\x0emaxReleaseYear\x12?\n" +
+	"\rcreated_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12\"\n" +
+	"\n" +
+	"min_rating\x18\t \x01(\x01H\x00R\tminRating\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_rating\x18\n" +
+	" \x01(\x01H\x01R\tmaxRating\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x123\n" +
+	"\x13include_total_count\x18\f \x01(\bH\x02R\x11includeTotalCount\x88\x01\x01B\r\n" +
+	"\v_min_ratingB\r\n" +
+	"\v_max_ratingB\x16\n" +
+	"\x14_include_total_count\"\x87\x01\n" +
 	"\x12ReadMoviesResponse\x12$\n" +
 	"\x06movies\x18\x01 \x03(\v2\f.proto.MovieR\x06movies\x12#\n" +
-	"\rtotal_records\x18\x02 \x01(\x03R\ftotalRecords\"8\n" +
+	"\rtotal_records\x18\x02 \x01(\x03R\ftotalRecords\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\\\n" +
+	"\x13SearchMoviesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x90\x01\n" +
+	"\x11MovieSearchResult\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\"o\n" +
+	"\x14SearchMoviesResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.proto.MovieSearchResultR\aresults\x12#\n" +
+	"\rtotal_records\x18\x02 \x01(\x03R\ftotalRecords\"4\n" +
+	"\x13StreamMoviesRequest\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x01 \x01(\x05R\tbatchSize\"9\n" +
+	"\x13ImportMoviesRequest\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"M\n" +
+	"\vImportError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x8e\x01\n" +
+	"\x14ImportMoviesResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12*\n" +
+	"\x06errors\x18\x04 \x03(\v2\x12.proto.ImportErrorR\x06errors\":\n" +
+	"\n" +
+	"BatchError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"o\n" +
+	"\x10BatchMovieResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\x05movie\x18\x02 \x01(\v2\f.proto.MovieR\x05movie\x12'\n" +
+	"\x05error\x18\x03 \x01(\v2\x11.proto.BatchErrorR\x05error\")\n" +
+	"\x15BatchGetMoviesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"K\n" +
+	"\x16BatchGetMoviesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.proto.BatchMovieResultR\aresults\"@\n" +
+	"\x18BatchCreateMoviesRequest\x12$\n" +
+	"\x06movies\x18\x01 \x03(\v2\f.proto.MovieR\x06movies\"N\n" +
+	"\x19BatchCreateMoviesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.proto.BatchMovieResultR\aresults\",\n" +
+	"\x18BatchDeleteMoviesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"N\n" +
+	"\x19BatchDeleteMoviesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.proto.BatchMovieResultR\aresults\"\xcd\x01\n" +
+	"\n" +
+	"MovieEvent\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.proto.MovieEventTypeR\x04type\x12\x19\n" +
+	"\bmovie_id\x18\x03 \x01(\tR\amovieId\x12\"\n" +
+	"\x05movie\x18\x04 \x01(\v2\f.proto.MovieR\x05movie\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"S\n" +
+	"\x12WatchMoviesRequest\x12*\n" +
+	"\x0eafter_revision\x18\x01 \x01(\x03H\x00R\rafterRevision\x88\x01\x01B\x11\n" +
+	"\x0f_after_revision\"\xba\x01\n" +
 	"\x12UpdateMovieRequest\x12\"\n" +
-	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"9\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"9\n" +
 	"\x13UpdateMovieResponse\x12\"\n" +
-	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"$\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"i\n" +
 	"\x12DeleteMovieRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"/\n" +
 	"\x13DeleteMovieResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xeb\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"&\n" +
+	"\x14UndeleteMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x15UndeleteMovieResponse\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\"K\n" +
+	"\x18ListDeletedMoviesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"f\n" +
+	"\x19ListDeletedMoviesResponse\x12$\n" +
+	"\x06movies\x18\x01 \x03(\v2\f.proto.MovieR\x06movies\x12#\n" +
+	"\rtotal_records\x18\x02 \x01(\x03R\ftotalRecords\"#\n" +
+	"\x11PurgeMovieRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x12PurgeMovieResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
+	"\x15FindDuplicatesRequest\x12%\n" +
+	"\x0emin_similarity\x18\x01 \x01(\x01R\rminSimilarity\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x81\x01\n" +
+	"\x0fDuplicateMovies\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\x12*\n" +
+	"\tduplicate\x18\x02 \x01(\v2\f.proto.MovieR\tduplicate\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\"P\n" +
+	"\x16FindDuplicatesResponse\x126\n" +
+	"\n" +
+	"duplicates\x18\x01 \x03(\v2\x16.proto.DuplicateMoviesR\n" +
+	"duplicates\"8\n" +
+	"\x12CreateGenreRequest\x12\"\n" +
+	"\x05genre\x18\x01 \x01(\v2\f.proto.GenreR\x05genre\"9\n" +
+	"\x13CreateGenreResponse\x12\"\n" +
+	"\x05genre\x18\x01 \x01(\v2\f.proto.GenreR\x05genre\"\"\n" +
+	"\x10ReadGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x11ReadGenreResponse\x12\"\n" +
+	"\x05genre\x18\x01 \x01(\v2\f.proto.GenreR\x05genre\"\x13\n" +
+	"\x11ReadGenresRequest\":\n" +
+	"\x12ReadGenresResponse\x12$\n" +
+	"\x06genres\x18\x01 \x03(\v2\f.proto.GenreR\x06genres\"8\n" +
+	"\x12UpdateGenreRequest\x12\"\n" +
+	"\x05genre\x18\x01 \x01(\v2\f.proto.GenreR\x05genre\"9\n" +
+	"\x13UpdateGenreResponse\x12\"\n" +
+	"\x05genre\x18\x01 \x01(\v2\f.proto.GenreR\x05genre\"$\n" +
+	"\x12DeleteGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x13DeleteGenreResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x13CreatePersonRequest\x12%\n" +
+	"\x06person\x18\x01 \x01(\v2\r.proto.PersonR\x06person\"=\n" +
+	"\x14CreatePersonResponse\x12%\n" +
+	"\x06person\x18\x01 \x01(\v2\r.proto.PersonR\x06person\"#\n" +
+	"\x11ReadPersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x12ReadPersonResponse\x12%\n" +
+	"\x06person\x18\x01 \x01(\v2\r.proto.PersonR\x06person\"\\\n" +
+	"\x11ReadPeopleRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\"`\n" +
+	"\x12ReadPeopleResponse\x12%\n" +
+	"\x06people\x18\x01 \x03(\v2\r.proto.PersonR\x06people\x12#\n" +
+	"\rtotal_records\x18\x02 \x01(\x03R\ftotalRecords\"<\n" +
+	"\x13UpdatePersonRequest\x12%\n" +
+	"\x06person\x18\x01 \x01(\v2\r.proto.PersonR\x06person\"=\n" +
+	"\x14UpdatePersonResponse\x12%\n" +
+	"\x06person\x18\x01 \x01(\v2\r.proto.PersonR\x06person\"%\n" +
+	"\x13DeletePersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x14DeletePersonResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x13CreateCreditRequest\x12%\n" +
+	"\x06credit\x18\x01 \x01(\v2\r.proto.CreditR\x06credit\"=\n" +
+	"\x14CreateCreditResponse\x12%\n" +
+	"\x06credit\x18\x01 \x01(\v2\r.proto.CreditR\x06credit\"%\n" +
+	"\x13DeleteCreditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x14DeleteCreditResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"]\n" +
+	"\x17ReadPersonMoviesRequest\x12\x1b\n" +
+	"\tperson_id\x18\x01 \x01(\tR\bpersonId\x12%\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x11.proto.CreditRoleR\x04role\"X\n" +
+	"\vPersonMovie\x12\"\n" +
+	"\x05movie\x18\x01 \x01(\v2\f.proto.MovieR\x05movie\x12%\n" +
+	"\x06credit\x18\x02 \x01(\v2\r.proto.CreditR\x06credit\"F\n" +
+	"\x18ReadPersonMoviesResponse\x12*\n" +
+	"\x06movies\x18\x01 \x03(\v2\x12.proto.PersonMovieR\x06movies\"<\n" +
+	"\x13CreateReviewRequest\x12%\n" +
+	"\x06review\x18\x01 \x01(\v2\r.proto.ReviewR\x06review\"=\n" +
+	"\x14CreateReviewResponse\x12%\n" +
+	"\x06review\x18\x01 \x01(\v2\r.proto.ReviewR\x06review\"<\n" +
+	"\x13UpdateReviewRequest\x12%\n" +
+	"\x06review\x18\x01 \x01(\v2\r.proto.ReviewR\x06review\"=\n" +
+	"\x14UpdateReviewResponse\x12%\n" +
+	"\x06review\x18\x01 \x01(\v2\r.proto.ReviewR\x06review\"%\n" +
+	"\x13DeleteReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x14DeleteReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"`\n" +
+	"\x12ReadReviewsRequest\x12\x19\n" +
+	"\bmovie_id\x18\x01 \x01(\tR\amovieId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"c\n" +
+	"\x13ReadReviewsResponse\x12'\n" +
+	"\areviews\x18\x01 \x03(\v2\r.proto.ReviewR\areviews\x12#\n" +
+	"\rtotal_records\x18\x02 \x01(\x03R\ftotalRecords*\x8a\x01\n" +
+	"\tAgeRating\x12\x1a\n" +
+	"\x16AGE_RATING_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fAGE_RATING_G\x10\x01\x12\x11\n" +
+	"\rAGE_RATING_PG\x10\x02\x12\x14\n" +
+	"\x10AGE_RATING_PG_13\x10\x03\x12\x10\n" +
+	"\fAGE_RATING_R\x10\x04\x12\x14\n" +
+	"\x10AGE_RATING_NC_17\x10\x05*\xa1\x01\n" +
+	"\n" +
+	"CreditRole\x12\x1b\n" +
+	"\x17CREDIT_ROLE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CREDIT_ROLE_DIRECTOR\x10\x01\x12\x16\n" +
+	"\x12CREDIT_ROLE_WRITER\x10\x02\x12\x18\n" +
+	"\x14CREDIT_ROLE_PRODUCER\x10\x03\x12\x14\n" +
+	"\x10CREDIT_ROLE_CAST\x10\x04\x12\x14\n" +
+	"\x10CREDIT_ROLE_CREW\x10\x05*\x8c\x01\n" +
+	"\x0eMovieEventType\x12 \n" +
+	"\x1cMOVIE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MOVIE_EVENT_TYPE_CREATED\x10\x01\x12\x1c\n" +
+	"\x18MOVIE_EVENT_TYPE_UPDATED\x10\x02\x12\x1c\n" +
+	"\x18MOVIE_EVENT_TYPE_DELETED\x10\x032\xa2\f\n" +
 	"\fMovieService\x12F\n" +
 	"\vCreateMovie\x12\x19.proto.CreateMovieRequest\x1a\x1a.proto.CreateMovieResponse\"\x00\x12?\n" +
 	"\bGetMovie\x12\x17.proto.ReadMovieRequest\x1a\x18.proto.ReadMovieResponse\"\x00\x12B\n" +
-	"\tGetMovies\x12\x18.proto.ReadMoviesRequest\x1a\x19.proto.ReadMoviesResponse\"\x00\x12F\n" +
+	"\tGetMovies\x12\x18.proto.ReadMoviesRequest\x1a\x19.proto.ReadMoviesResponse\"\x00\x12I\n" +
+	"\fSearchMovies\x12\x1a.proto.SearchMoviesRequest\x1a\x1b.proto.SearchMoviesResponse\"\x00\x12<\n" +
+	"\fStreamMovies\x12\x1a.proto.StreamMoviesRequest\x1a\f.proto.Movie\"\x000\x01\x12K\n" +
+	"\fImportMovies\x12\x1a.proto.ImportMoviesRequest\x1a\x1b.proto.ImportMoviesResponse\"\x00(\x01\x12O\n" +
+	"\x0eBatchGetMovies\x12\x1c.proto.BatchGetMoviesRequest\x1a\x1d.proto.BatchGetMoviesResponse\"\x00\x12X\n" +
+	"\x11BatchCreateMovies\x12\x1f.proto.BatchCreateMoviesRequest\x1a .proto.BatchCreateMoviesResponse\"\x00\x12X\n" +
+	"\x11BatchDeleteMovies\x12\x1f.proto.BatchDeleteMoviesRequest\x1a .proto.BatchDeleteMoviesResponse\"\x00\x12?\n" +
+	"\vWatchMovies\x12\x19.proto.WatchMoviesRequest\x1a\x11.proto.MovieEvent\"\x000\x01\x12F\n" +
 	"\vUpdateMovie\x12\x19.proto.UpdateMovieRequest\x1a\x1a.proto.UpdateMovieResponse\"\x00\x12F\n" +
-	"\vDeleteMovie\x12\x19.proto.DeleteMovieRequest\x1a\x1a.proto.DeleteMovieResponse\"\x00B'Z%github.com/renaldyhidayatt/movie_grpcb\x06proto3"
+	"\vDeleteMovie\x12\x19.proto.DeleteMovieRequest\x1a\x1a.proto.DeleteMovieResponse\"\x00\x12L\n" +
+	"\rUndeleteMovie\x12\x1b.proto.UndeleteMovieRequest\x1a\x1c.proto.UndeleteMovieResponse\"\x00\x12X\n" +
+	"\x11ListDeletedMovies\x12\x1f.proto.ListDeletedMoviesRequest\x1a .proto.ListDeletedMoviesResponse\"\x00\x12C\n" +
+	"\n" +
+	"PurgeMovie\x12\x18.proto.PurgeMovieRequest\x1a\x19.proto.PurgeMovieResponse\"\x00\x12O\n" +
+	"\x0eFindDuplicates\x12\x1c.proto.FindDuplicatesRequest\x1a\x1d.proto.FindDuplicatesResponse\"\x00\x12F\n" +
+	"\vCreateGenre\x12\x19.proto.CreateGenreRequest\x1a\x1a.proto.CreateGenreResponse\"\x00\x12?\n" +
+	"\bGetGenre\x12\x17.proto.ReadGenreRequest\x1a\x18.proto.ReadGenreResponse\"\x00\x12B\n" +
+	"\tGetGenres\x12\x18.proto.ReadGenresRequest\x1a\x19.proto.ReadGenresResponse\"\x00\x12F\n" +
+	"\vUpdateGenre\x12\x19.proto.UpdateGenreRequest\x1a\x1a.proto.UpdateGenreResponse\"\x00\x12F\n" +
+	"\vDeleteGenre\x12\x19.proto.DeleteGenreRequest\x1a\x1a.proto.DeleteGenreResponse\"\x002\xe4\x04\n" +
+	"\rPeopleService\x12I\n" +
+	"\fCreatePerson\x12\x1a.proto.CreatePersonRequest\x1a\x1b.proto.CreatePersonResponse\"\x00\x12B\n" +
+	"\tGetPerson\x12\x18.proto.ReadPersonRequest\x1a\x19.proto.ReadPersonResponse\"\x00\x12B\n" +
+	"\tGetPeople\x12\x18.proto.ReadPeopleRequest\x1a\x19.proto.ReadPeopleResponse\"\x00\x12I\n" +
+	"\fUpdatePerson\x12\x1a.proto.UpdatePersonRequest\x1a\x1b.proto.UpdatePersonResponse\"\x00\x12I\n" +
+	"\fDeletePerson\x12\x1a.proto.DeletePersonRequest\x1a\x1b.proto.DeletePersonResponse\"\x00\x12I\n" +
+	"\fCreateCredit\x12\x1a.proto.CreateCreditRequest\x1a\x1b.proto.CreateCreditResponse\"\x00\x12I\n" +
+	"\fDeleteCredit\x12\x1a.proto.DeleteCreditRequest\x1a\x1b.proto.DeleteCreditResponse\"\x00\x12T\n" +
+	"\x0fGetPersonMovies\x12\x1e.proto.ReadPersonMoviesRequest\x1a\x1f.proto.ReadPersonMoviesResponse\"\x002\xb7\x02\n" +
+	"\rReviewService\x12I\n" +
+	"\fCreateReview\x12\x1a.proto.CreateReviewRequest\x1a\x1b.proto.CreateReviewResponse\"\x00\x12I\n" +
+	"\fUpdateReview\x12\x1a.proto.UpdateReviewRequest\x1a\x1b.proto.UpdateReviewResponse\"\x00\x12I\n" +
+	"\fDeleteReview\x12\x1a.proto.DeleteReviewRequest\x1a\x1b.proto.DeleteReviewResponse\"\x00\x12E\n" +
+	"\n" +
+	"GetReviews\x12\x19.proto.ReadReviewsRequest\x1a\x1a.proto.ReadReviewsResponse\"\x00B'Z%github.com/renaldyhidayatt/movie_grpcb\x06proto3"
 
 var (
 	file_movie_proto_rawDescOnce sync.Once
@@ -596,42 +4606,222 @@ func file_movie_proto_rawDescGZIP() []byte {
 	return file_movie_proto_rawDescData
 }
 
-var file_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_movie_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_movie_proto_goTypes = []any{
-	(*Movie)(nil),               // 0: proto.Movie
-	(*CreateMovieRequest)(nil),  // 1: proto.CreateMovieRequest
-	(*CreateMovieResponse)(nil), // 2: proto.CreateMovieResponse
-	(*ReadMovieRequest)(nil),    // 3: proto.ReadMovieRequest
-	(*ReadMovieResponse)(nil),   // 4: proto.ReadMovieResponse
-	(*ReadMoviesRequest)(nil),   // 5: proto.ReadMoviesRequest
-	(*ReadMoviesResponse)(nil),  // 6: proto.ReadMoviesResponse
-	(*UpdateMovieRequest)(nil),  // 7: proto.UpdateMovieRequest
-	(*UpdateMovieResponse)(nil), // 8: proto.UpdateMovieResponse
-	(*DeleteMovieRequest)(nil),  // 9: proto.DeleteMovieRequest
-	(*DeleteMovieResponse)(nil), // 10: proto.DeleteMovieResponse
+	(AgeRating)(0),                    // 0: proto.AgeRating
+	(CreditRole)(0),                   // 1: proto.CreditRole
+	(MovieEventType)(0),               // 2: proto.MovieEventType
+	(*Movie)(nil),                     // 3: proto.Movie
+	(*Review)(nil),                    // 4: proto.Review
+	(*Person)(nil),                    // 5: proto.Person
+	(*Credit)(nil),                    // 6: proto.Credit
+	(*Genre)(nil),                     // 7: proto.Genre
+	(*CreateMovieRequest)(nil),        // 8: proto.CreateMovieRequest
+	(*CreateMovieResponse)(nil),       // 9: proto.CreateMovieResponse
+	(*ReadMovieRequest)(nil),          // 10: proto.ReadMovieRequest
+	(*ReadMovieResponse)(nil),         // 11: proto.ReadMovieResponse
+	(*ReadMoviesRequest)(nil),         // 12: proto.ReadMoviesRequest
+	(*ReadMoviesResponse)(nil),        // 13: proto.ReadMoviesResponse
+	(*SearchMoviesRequest)(nil),       // 14: proto.SearchMoviesRequest
+	(*MovieSearchResult)(nil),         // 15: proto.MovieSearchResult
+	(*SearchMoviesResponse)(nil),      // 16: proto.SearchMoviesResponse
+	(*StreamMoviesRequest)(nil),       // 17: proto.StreamMoviesRequest
+	(*ImportMoviesRequest)(nil),       // 18: proto.ImportMoviesRequest
+	(*ImportError)(nil),               // 19: proto.ImportError
+	(*ImportMoviesResponse)(nil),      // 20: proto.ImportMoviesResponse
+	(*BatchError)(nil),                // 21: proto.BatchError
+	(*BatchMovieResult)(nil),          // 22: proto.BatchMovieResult
+	(*BatchGetMoviesRequest)(nil),     // 23: proto.BatchGetMoviesRequest
+	(*BatchGetMoviesResponse)(nil),    // 24: proto.BatchGetMoviesResponse
+	(*BatchCreateMoviesRequest)(nil),  // 25: proto.BatchCreateMoviesRequest
+	(*BatchCreateMoviesResponse)(nil), // 26: proto.BatchCreateMoviesResponse
+	(*BatchDeleteMoviesRequest)(nil),  // 27: proto.BatchDeleteMoviesRequest
+	(*BatchDeleteMoviesResponse)(nil), // 28: proto.BatchDeleteMoviesResponse
+	(*MovieEvent)(nil),                // 29: proto.MovieEvent
+	(*WatchMoviesRequest)(nil),        // 30: proto.WatchMoviesRequest
+	(*UpdateMovieRequest)(nil),        // 31: proto.UpdateMovieRequest
+	(*UpdateMovieResponse)(nil),       // 32: proto.UpdateMovieResponse
+	(*DeleteMovieRequest)(nil),        // 33: proto.DeleteMovieRequest
+	(*DeleteMovieResponse)(nil),       // 34: proto.DeleteMovieResponse
+	(*UndeleteMovieRequest)(nil),      // 35: proto.UndeleteMovieRequest
+	(*UndeleteMovieResponse)(nil),     // 36: proto.UndeleteMovieResponse
+	(*ListDeletedMoviesRequest)(nil),  // 37: proto.ListDeletedMoviesRequest
+	(*ListDeletedMoviesResponse)(nil), // 38: proto.ListDeletedMoviesResponse
+	(*PurgeMovieRequest)(nil),         // 39: proto.PurgeMovieRequest
+	(*PurgeMovieResponse)(nil),        // 40: proto.PurgeMovieResponse
+	(*FindDuplicatesRequest)(nil),     // 41: proto.FindDuplicatesRequest
+	(*DuplicateMovies)(nil),           // 42: proto.DuplicateMovies
+	(*FindDuplicatesResponse)(nil),    // 43: proto.FindDuplicatesResponse
+	(*CreateGenreRequest)(nil),        // 44: proto.CreateGenreRequest
+	(*CreateGenreResponse)(nil),       // 45: proto.CreateGenreResponse
+	(*ReadGenreRequest)(nil),          // 46: proto.ReadGenreRequest
+	(*ReadGenreResponse)(nil),         // 47: proto.ReadGenreResponse
+	(*ReadGenresRequest)(nil),         // 48: proto.ReadGenresRequest
+	(*ReadGenresResponse)(nil),        // 49: proto.ReadGenresResponse
+	(*UpdateGenreRequest)(nil),        // 50: proto.UpdateGenreRequest
+	(*UpdateGenreResponse)(nil),       // 51: proto.UpdateGenreResponse
+	(*DeleteGenreRequest)(nil),        // 52: proto.DeleteGenreRequest
+	(*DeleteGenreResponse)(nil),       // 53: proto.DeleteGenreResponse
+	(*CreatePersonRequest)(nil),       // 54: proto.CreatePersonRequest
+	(*CreatePersonResponse)(nil),      // 55: proto.CreatePersonResponse
+	(*ReadPersonRequest)(nil),         // 56: proto.ReadPersonRequest
+	(*ReadPersonResponse)(nil),        // 57: proto.ReadPersonResponse
+	(*ReadPeopleRequest)(nil),         // 58: proto.ReadPeopleRequest
+	(*ReadPeopleResponse)(nil),        // 59: proto.ReadPeopleResponse
+	(*UpdatePersonRequest)(nil),       // 60: proto.UpdatePersonRequest
+	(*UpdatePersonResponse)(nil),      // 61: proto.UpdatePersonResponse
+	(*DeletePersonRequest)(nil),       // 62: proto.DeletePersonRequest
+	(*DeletePersonResponse)(nil),      // 63: proto.DeletePersonResponse
+	(*CreateCreditRequest)(nil),       // 64: proto.CreateCreditRequest
+	(*CreateCreditResponse)(nil),      // 65: proto.CreateCreditResponse
+	(*DeleteCreditRequest)(nil),       // 66: proto.DeleteCreditRequest
+	(*DeleteCreditResponse)(nil),      // 67: proto.DeleteCreditResponse
+	(*ReadPersonMoviesRequest)(nil),   // 68: proto.ReadPersonMoviesRequest
+	(*PersonMovie)(nil),               // 69: proto.PersonMovie
+	(*ReadPersonMoviesResponse)(nil),  // 70: proto.ReadPersonMoviesResponse
+	(*CreateReviewRequest)(nil),       // 71: proto.CreateReviewRequest
+	(*CreateReviewResponse)(nil),      // 72: proto.CreateReviewResponse
+	(*UpdateReviewRequest)(nil),       // 73: proto.UpdateReviewRequest
+	(*UpdateReviewResponse)(nil),      // 74: proto.UpdateReviewResponse
+	(*DeleteReviewRequest)(nil),       // 75: proto.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),      // 76: proto.DeleteReviewResponse
+	(*ReadReviewsRequest)(nil),        // 77: proto.ReadReviewsRequest
+	(*ReadReviewsResponse)(nil),       // 78: proto.ReadReviewsResponse
+	(*timestamppb.Timestamp)(nil),     // 79: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),     // 80: google.protobuf.FieldMask
 }
 var file_movie_proto_depIdxs = []int32{
-	0,  // 0: proto.CreateMovieRequest.movie:type_name -> proto.Movie
-	0,  // 1: proto.CreateMovieResponse.movie:type_name -> proto.Movie
-	0,  // 2: proto.ReadMovieResponse.movie:type_name -> proto.Movie
-	0,  // 3: proto.ReadMoviesResponse.movies:type_name -> proto.Movie
-	0,  // 4: proto.UpdateMovieRequest.movie:type_name -> proto.Movie
-	0,  // 5: proto.UpdateMovieResponse.movie:type_name -> proto.Movie
-	1,  // 6: proto.MovieService.CreateMovie:input_type -> proto.CreateMovieRequest
-	3,  // 7: proto.MovieService.GetMovie:input_type -> proto.ReadMovieRequest
-	5,  // 8: proto.MovieService.GetMovies:input_type -> proto.ReadMoviesRequest
-	7,  // 9: proto.MovieService.UpdateMovie:input_type -> proto.UpdateMovieRequest
-	9,  // 10: proto.MovieService.DeleteMovie:input_type -> proto.DeleteMovieRequest
-	2,  // 11: proto.MovieService.CreateMovie:output_type -> proto.CreateMovieResponse
-	4,  // 12: proto.MovieService.GetMovie:output_type -> proto.ReadMovieResponse
-	6,  // 13: proto.MovieService.GetMovies:output_type -> proto.ReadMoviesResponse
-	8,  // 14: proto.MovieService.UpdateMovie:output_type -> proto.UpdateMovieResponse
-	10, // 15: proto.MovieService.DeleteMovie:output_type -> proto.DeleteMovieResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 0: proto.Movie.age_rating:type_name -> proto.AgeRating
+	79, // 1: proto.Movie.created_at:type_name -> google.protobuf.Timestamp
+	79, // 2: proto.Movie.updated_at:type_name -> google.protobuf.Timestamp
+	79, // 3: proto.Movie.deleted_at:type_name -> google.protobuf.Timestamp
+	79, // 4: proto.Review.created_at:type_name -> google.protobuf.Timestamp
+	79, // 5: proto.Review.updated_at:type_name -> google.protobuf.Timestamp
+	79, // 6: proto.Person.created_at:type_name -> google.protobuf.Timestamp
+	79, // 7: proto.Person.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: proto.Credit.role:type_name -> proto.CreditRole
+	3,  // 9: proto.CreateMovieRequest.movie:type_name -> proto.Movie
+	3,  // 10: proto.CreateMovieResponse.movie:type_name -> proto.Movie
+	3,  // 11: proto.ReadMovieResponse.movie:type_name -> proto.Movie
+	6,  // 12: proto.ReadMovieResponse.credits:type_name -> proto.Credit
+	79, // 13: proto.ReadMoviesRequest.created_after:type_name -> google.protobuf.Timestamp
+	3,  // 14: proto.ReadMoviesResponse.movies:type_name -> proto.Movie
+	3,  // 15: proto.MovieSearchResult.movie:type_name -> proto.Movie
+	15, // 16: proto.SearchMoviesResponse.results:type_name -> proto.MovieSearchResult
+	3,  // 17: proto.ImportMoviesRequest.movie:type_name -> proto.Movie
+	19, // 18: proto.ImportMoviesResponse.errors:type_name -> proto.ImportError
+	3,  // 19: proto.BatchMovieResult.movie:type_name -> proto.Movie
+	21, // 20: proto.BatchMovieResult.error:type_name -> proto.BatchError
+	22, // 21: proto.BatchGetMoviesResponse.results:type_name -> proto.BatchMovieResult
+	3,  // 22: proto.BatchCreateMoviesRequest.movies:type_name -> proto.Movie
+	22, // 23: proto.BatchCreateMoviesResponse.results:type_name -> proto.BatchMovieResult
+	22, // 24: proto.BatchDeleteMoviesResponse.results:type_name -> proto.BatchMovieResult
+	2,  // 25: proto.MovieEvent.type:type_name -> proto.MovieEventType
+	3,  // 26: proto.MovieEvent.movie:type_name -> proto.Movie
+	79, // 27: proto.MovieEvent.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 28: proto.UpdateMovieRequest.movie:type_name -> proto.Movie
+	80, // 29: proto.UpdateMovieRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 30: proto.UpdateMovieResponse.movie:type_name -> proto.Movie
+	3,  // 31: proto.UndeleteMovieResponse.movie:type_name -> proto.Movie
+	3,  // 32: proto.ListDeletedMoviesResponse.movies:type_name -> proto.Movie
+	3,  // 33: proto.DuplicateMovies.movie:type_name -> proto.Movie
+	3,  // 34: proto.DuplicateMovies.duplicate:type_name -> proto.Movie
+	42, // 35: proto.FindDuplicatesResponse.duplicates:type_name -> proto.DuplicateMovies
+	7,  // 36: proto.CreateGenreRequest.genre:type_name -> proto.Genre
+	7,  // 37: proto.CreateGenreResponse.genre:type_name -> proto.Genre
+	7,  // 38: proto.ReadGenreResponse.genre:type_name -> proto.Genre
+	7,  // 39: proto.ReadGenresResponse.genres:type_name -> proto.Genre
+	7,  // 40: proto.UpdateGenreRequest.genre:type_name -> proto.Genre
+	7,  // 41: proto.UpdateGenreResponse.genre:type_name -> proto.Genre
+	5,  // 42: proto.CreatePersonRequest.person:type_name -> proto.Person
+	5,  // 43: proto.CreatePersonResponse.person:type_name -> proto.Person
+	5,  // 44: proto.ReadPersonResponse.person:type_name -> proto.Person
+	5,  // 45: proto.ReadPeopleResponse.people:type_name -> proto.Person
+	5,  // 46: proto.UpdatePersonRequest.person:type_name -> proto.Person
+	5,  // 47: proto.UpdatePersonResponse.person:type_name -> proto.Person
+	6,  // 48: proto.CreateCreditRequest.credit:type_name -> proto.Credit
+	6,  // 49: proto.CreateCreditResponse.credit:type_name -> proto.Credit
+	1,  // 50: proto.ReadPersonMoviesRequest.role:type_name -> proto.CreditRole
+	3,  // 51: proto.PersonMovie.movie:type_name -> proto.Movie
+	6,  // 52: proto.PersonMovie.credit:type_name -> proto.Credit
+	69, // 53: proto.ReadPersonMoviesResponse.movies:type_name -> proto.PersonMovie
+	4,  // 54: proto.CreateReviewRequest.review:type_name -> proto.Review
+	4,  // 55: proto.CreateReviewResponse.review:type_name -> proto.Review
+	4,  // 56: proto.UpdateReviewRequest.review:type_name -> proto.Review
+	4,  // 57: proto.UpdateReviewResponse.review:type_name -> proto.Review
+	4,  // 58: proto.ReadReviewsResponse.reviews:type_name -> proto.Review
+	8,  // 59: proto.MovieService.CreateMovie:input_type -> proto.CreateMovieRequest
+	10, // 60: proto.MovieService.GetMovie:input_type -> proto.ReadMovieRequest
+	12, // 61: proto.MovieService.GetMovies:input_type -> proto.ReadMoviesRequest
+	14, // 62: proto.MovieService.SearchMovies:input_type -> proto.SearchMoviesRequest
+	17, // 63: proto.MovieService.StreamMovies:input_type -> proto.StreamMoviesRequest
+	18, // 64: proto.MovieService.ImportMovies:input_type -> proto.ImportMoviesRequest
+	23, // 65: proto.MovieService.BatchGetMovies:input_type -> proto.BatchGetMoviesRequest
+	25, // 66: proto.MovieService.BatchCreateMovies:input_type -> proto.BatchCreateMoviesRequest
+	27, // 67: proto.MovieService.BatchDeleteMovies:input_type -> proto.BatchDeleteMoviesRequest
+	30, // 68: proto.MovieService.WatchMovies:input_type -> proto.WatchMoviesRequest
+	31, // 69: proto.MovieService.UpdateMovie:input_type -> proto.UpdateMovieRequest
+	33, // 70: proto.MovieService.DeleteMovie:input_type -> proto.DeleteMovieRequest
+	35, // 71: proto.MovieService.UndeleteMovie:input_type -> proto.UndeleteMovieRequest
+	37, // 72: proto.MovieService.ListDeletedMovies:input_type -> proto.ListDeletedMoviesRequest
+	39, // 73: proto.MovieService.PurgeMovie:input_type -> proto.PurgeMovieRequest
+	41, // 74: proto.MovieService.FindDuplicates:input_type -> proto.FindDuplicatesRequest
+	44, // 75: proto.MovieService.CreateGenre:input_type -> proto.CreateGenreRequest
+	46, // 76: proto.MovieService.GetGenre:input_type -> proto.ReadGenreRequest
+	48, // 77: proto.MovieService.GetGenres:input_type -> proto.ReadGenresRequest
+	50, // 78: proto.MovieService.UpdateGenre:input_type -> proto.UpdateGenreRequest
+	52, // 79: proto.MovieService.DeleteGenre:input_type -> proto.DeleteGenreRequest
+	54, // 80: proto.PeopleService.CreatePerson:input_type -> proto.CreatePersonRequest
+	56, // 81: proto.PeopleService.GetPerson:input_type -> proto.ReadPersonRequest
+	58, // 82: proto.PeopleService.GetPeople:input_type -> proto.ReadPeopleRequest
+	60, // 83: proto.PeopleService.UpdatePerson:input_type -> proto.UpdatePersonRequest
+	62, // 84: proto.PeopleService.DeletePerson:input_type -> proto.DeletePersonRequest
+	64, // 85: proto.PeopleService.CreateCredit:input_type -> proto.CreateCreditRequest
+	66, // 86: proto.PeopleService.DeleteCredit:input_type -> proto.DeleteCreditRequest
+	68, // 87: proto.PeopleService.GetPersonMovies:input_type -> proto.ReadPersonMoviesRequest
+	71, // 88: proto.ReviewService.CreateReview:input_type -> proto.CreateReviewRequest
+	73, // 89: proto.ReviewService.UpdateReview:input_type -> proto.UpdateReviewRequest
+	75, // 90: proto.ReviewService.DeleteReview:input_type -> proto.DeleteReviewRequest
+	77, // 91: proto.ReviewService.GetReviews:input_type -> proto.ReadReviewsRequest
+	9,  // 92: proto.MovieService.CreateMovie:output_type -> proto.CreateMovieResponse
+	11, // 93: proto.MovieService.GetMovie:output_type -> proto.ReadMovieResponse
+	13, // 94: proto.MovieService.GetMovies:output_type -> proto.ReadMoviesResponse
+	16, // 95: proto.MovieService.SearchMovies:output_type -> proto.SearchMoviesResponse
+	3,  // 96: proto.MovieService.StreamMovies:output_type -> proto.Movie
+	20, // 97: proto.MovieService.ImportMovies:output_type -> proto.ImportMoviesResponse
+	24, // 98: proto.MovieService.BatchGetMovies:output_type -> proto.BatchGetMoviesResponse
+	26, // 99: proto.MovieService.BatchCreateMovies:output_type -> proto.BatchCreateMoviesResponse
+	28, // 100: proto.MovieService.BatchDeleteMovies:output_type -> proto.BatchDeleteMoviesResponse
+	29, // 101: proto.MovieService.WatchMovies:output_type -> proto.MovieEvent
+	32, // 102: proto.MovieService.UpdateMovie:output_type -> proto.UpdateMovieResponse
+	34, // 103: proto.MovieService.DeleteMovie:output_type -> proto.DeleteMovieResponse
+	36, // 104: proto.MovieService.UndeleteMovie:output_type -> proto.UndeleteMovieResponse
+	38, // 105: proto.MovieService.ListDeletedMovies:output_type -> proto.ListDeletedMoviesResponse
+	40, // 106: proto.MovieService.PurgeMovie:output_type -> proto.PurgeMovieResponse
+	43, // 107: proto.MovieService.FindDuplicates:output_type -> proto.FindDuplicatesResponse
+	45, // 108: proto.MovieService.CreateGenre:output_type -> proto.CreateGenreResponse
+	47, // 109: proto.MovieService.GetGenre:output_type -> proto.ReadGenreResponse
+	49, // 110: proto.MovieService.GetGenres:output_type -> proto.ReadGenresResponse
+	51, // 111: proto.MovieService.UpdateGenre:output_type -> proto.UpdateGenreResponse
+	53, // 112: proto.MovieService.DeleteGenre:output_type -> proto.DeleteGenreResponse
+	55, // 113: proto.PeopleService.CreatePerson:output_type -> proto.CreatePersonResponse
+	57, // 114: proto.PeopleService.GetPerson:output_type -> proto.ReadPersonResponse
+	59, // 115: proto.PeopleService.GetPeople:output_type -> proto.ReadPeopleResponse
+	61, // 116: proto.PeopleService.UpdatePerson:output_type -> proto.UpdatePersonResponse
+	63, // 117: proto.PeopleService.DeletePerson:output_type -> proto.DeletePersonResponse
+	65, // 118: proto.PeopleService.CreateCredit:output_type -> proto.CreateCreditResponse
+	67, // 119: proto.PeopleService.DeleteCredit:output_type -> proto.DeleteCreditResponse
+	70, // 120: proto.PeopleService.GetPersonMovies:output_type -> proto.ReadPersonMoviesResponse
+	72, // 121: proto.ReviewService.CreateReview:output_type -> proto.CreateReviewResponse
+	74, // 122: proto.ReviewService.UpdateReview:output_type -> proto.UpdateReviewResponse
+	76, // 123: proto.ReviewService.DeleteReview:output_type -> proto.DeleteReviewResponse
+	78, // 124: proto.ReviewService.GetReviews:output_type -> proto.ReadReviewsResponse
+	92, // [92:125] is the sub-list for method output_type
+	59, // [59:92] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_movie_proto_init() }
@@ -639,18 +4829,23 @@ func file_movie_proto_init() {
 	if File_movie_proto != nil {
 		return
 	}
+	file_movie_proto_msgTypes[9].OneofWrappers = []any{}
+	file_movie_proto_msgTypes[27].OneofWrappers = []any{}
+	file_movie_proto_msgTypes[28].OneofWrappers = []any{}
+	file_movie_proto_msgTypes[30].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_proto_rawDesc), len(file_movie_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   76,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_movie_proto_goTypes,
 		DependencyIndexes: file_movie_proto_depIdxs,
+		EnumInfos:         file_movie_proto_enumTypes,
 		MessageInfos:      file_movie_proto_msgTypes,
 	}.Build()
 	File_movie_proto = out.File
@@ -19,11 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MovieService_CreateMovie_FullMethodName = "/proto.MovieService/CreateMovie"
-	MovieService_GetMovie_FullMethodName    = "/proto.MovieService/GetMovie"
-	MovieService_GetMovies_FullMethodName   = "/proto.MovieService/GetMovies"
-	MovieService_UpdateMovie_FullMethodName = "/proto.MovieService/UpdateMovie"
-	MovieService_DeleteMovie_FullMethodName = "/proto.MovieService/DeleteMovie"
+	MovieService_CreateMovie_FullMethodName       = "/proto.MovieService/CreateMovie"
+	MovieService_GetMovie_FullMethodName          = "/proto.MovieService/GetMovie"
+	MovieService_GetMovies_FullMethodName         = "/proto.MovieService/GetMovies"
+	MovieService_SearchMovies_FullMethodName      = "/proto.MovieService/SearchMovies"
+	MovieService_StreamMovies_FullMethodName      = "/proto.MovieService/StreamMovies"
+	MovieService_ImportMovies_FullMethodName      = "/proto.MovieService/ImportMovies"
+	MovieService_BatchGetMovies_FullMethodName    = "/proto.MovieService/BatchGetMovies"
+	MovieService_BatchCreateMovies_FullMethodName = "/proto.MovieService/BatchCreateMovies"
+	MovieService_BatchDeleteMovies_FullMethodName = "/proto.MovieService/BatchDeleteMovies"
+	MovieService_WatchMovies_FullMethodName       = "/proto.MovieService/WatchMovies"
+	MovieService_UpdateMovie_FullMethodName       = "/proto.MovieService/UpdateMovie"
+	MovieService_DeleteMovie_FullMethodName       = "/proto.MovieService/DeleteMovie"
+	MovieService_UndeleteMovie_FullMethodName     = "/proto.MovieService/UndeleteMovie"
+	MovieService_ListDeletedMovies_FullMethodName = "/proto.MovieService/ListDeletedMovies"
+	MovieService_PurgeMovie_FullMethodName        = "/proto.MovieService/PurgeMovie"
+	MovieService_FindDuplicates_FullMethodName    = "/proto.MovieService/FindDuplicates"
+	MovieService_CreateGenre_FullMethodName       = "/proto.MovieService/CreateGenre"
+	MovieService_GetGenre_FullMethodName          = "/proto.MovieService/GetGenre"
+	MovieService_GetGenres_FullMethodName         = "/proto.MovieService/GetGenres"
+	MovieService_UpdateGenre_FullMethodName       = "/proto.MovieService/UpdateGenre"
+	MovieService_DeleteGenre_FullMethodName       = "/proto.MovieService/DeleteGenre"
 )
 
 // MovieServiceClient is the client API for MovieService service.
@@ -33,8 +49,24 @@ type MovieServiceClient interface {
 	CreateMovie(ctx context.Context, in *CreateMovieRequest, opts ...grpc.CallOption) (*CreateMovieResponse, error)
 	GetMovie(ctx context.Context, in *ReadMovieRequest, opts ...grpc.CallOption) (*ReadMovieResponse, error)
 	GetMovies(ctx context.Context, in *ReadMoviesRequest, opts ...grpc.CallOption) (*ReadMoviesResponse, error)
+	SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error)
+	StreamMovies(ctx context.Context, in *StreamMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Movie], error)
+	ImportMovies(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportMoviesRequest, ImportMoviesResponse], error)
+	BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...grpc.CallOption) (*BatchGetMoviesResponse, error)
+	BatchCreateMovies(ctx context.Context, in *BatchCreateMoviesRequest, opts ...grpc.CallOption) (*BatchCreateMoviesResponse, error)
+	BatchDeleteMovies(ctx context.Context, in *BatchDeleteMoviesRequest, opts ...grpc.CallOption) (*BatchDeleteMoviesResponse, error)
+	WatchMovies(ctx context.Context, in *WatchMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MovieEvent], error)
 	UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error)
 	DeleteMovie(ctx context.Context, in *DeleteMovieRequest, opts ...grpc.CallOption) (*DeleteMovieResponse, error)
+	UndeleteMovie(ctx context.Context, in *UndeleteMovieRequest, opts ...grpc.CallOption) (*UndeleteMovieResponse, error)
+	ListDeletedMovies(ctx context.Context, in *ListDeletedMoviesRequest, opts ...grpc.CallOption) (*ListDeletedMoviesResponse, error)
+	PurgeMovie(ctx context.Context, in *PurgeMovieRequest, opts ...grpc.CallOption) (*PurgeMovieResponse, error)
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*CreateGenreResponse, error)
+	GetGenre(ctx context.Context, in *ReadGenreRequest, opts ...grpc.CallOption) (*ReadGenreResponse, error)
+	GetGenres(ctx context.Context, in *ReadGenresRequest, opts ...grpc.CallOption) (*ReadGenresResponse, error)
+	UpdateGenre(ctx context.Context, in *UpdateGenreRequest, opts ...grpc.CallOption) (*UpdateGenreResponse, error)
+	DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*DeleteGenreResponse, error)
 }
 
 type movieServiceClient struct {
@@ -75,6 +107,97 @@ func (c *movieServiceClient) GetMovies(ctx context.Context, in *ReadMoviesReques
 	return out, nil
 }
 
+func (c *movieServiceClient) SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_SearchMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) StreamMovies(ctx context.Context, in *StreamMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Movie], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[0], MovieService_StreamMovies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMoviesRequest, Movie]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_StreamMoviesClient = grpc.ServerStreamingClient[Movie]
+
+func (c *movieServiceClient) ImportMovies(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportMoviesRequest, ImportMoviesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[1], MovieService_ImportMovies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportMoviesRequest, ImportMoviesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_ImportMoviesClient = grpc.ClientStreamingClient[ImportMoviesRequest, ImportMoviesResponse]
+
+func (c *movieServiceClient) BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...grpc.CallOption) (*BatchGetMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_BatchGetMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) BatchCreateMovies(ctx context.Context, in *BatchCreateMoviesRequest, opts ...grpc.CallOption) (*BatchCreateMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_BatchCreateMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) BatchDeleteMovies(ctx context.Context, in *BatchDeleteMoviesRequest, opts ...grpc.CallOption) (*BatchDeleteMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_BatchDeleteMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) WatchMovies(ctx context.Context, in *WatchMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MovieEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[2], MovieService_WatchMovies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMoviesRequest, MovieEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_WatchMoviesClient = grpc.ServerStreamingClient[MovieEvent]
+
 func (c *movieServiceClient) UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateMovieResponse)
@@ -95,6 +218,96 @@ func (c *movieServiceClient) DeleteMovie(ctx context.Context, in *DeleteMovieReq
 	return out, nil
 }
 
+func (c *movieServiceClient) UndeleteMovie(ctx context.Context, in *UndeleteMovieRequest, opts ...grpc.CallOption) (*UndeleteMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeleteMovieResponse)
+	err := c.cc.Invoke(ctx, MovieService_UndeleteMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListDeletedMovies(ctx context.Context, in *ListDeletedMoviesRequest, opts ...grpc.CallOption) (*ListDeletedMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_ListDeletedMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) PurgeMovie(ctx context.Context, in *PurgeMovieRequest, opts ...grpc.CallOption) (*PurgeMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeMovieResponse)
+	err := c.cc.Invoke(ctx, MovieService_PurgeMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicatesResponse)
+	err := c.cc.Invoke(ctx, MovieService_FindDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*CreateGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGenreResponse)
+	err := c.cc.Invoke(ctx, MovieService_CreateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetGenre(ctx context.Context, in *ReadGenreRequest, opts ...grpc.CallOption) (*ReadGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadGenreResponse)
+	err := c.cc.Invoke(ctx, MovieService_GetGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetGenres(ctx context.Context, in *ReadGenresRequest, opts ...grpc.CallOption) (*ReadGenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadGenresResponse)
+	err := c.cc.Invoke(ctx, MovieService_GetGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) UpdateGenre(ctx context.Context, in *UpdateGenreRequest, opts ...grpc.CallOption) (*UpdateGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGenreResponse)
+	err := c.cc.Invoke(ctx, MovieService_UpdateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*DeleteGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGenreResponse)
+	err := c.cc.Invoke(ctx, MovieService_DeleteGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MovieServiceServer is the server API for MovieService service.
 // All implementations must embed UnimplementedMovieServiceServer
 // for forward compatibility.
@@ -102,8 +315,24 @@ type MovieServiceServer interface {
 	CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieResponse, error)
 	GetMovie(context.Context, *ReadMovieRequest) (*ReadMovieResponse, error)
 	GetMovies(context.Context, *ReadMoviesRequest) (*ReadMoviesResponse, error)
+	SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error)
+	StreamMovies(*StreamMoviesRequest, grpc.ServerStreamingServer[Movie]) error
+	ImportMovies(grpc.ClientStreamingServer[ImportMoviesRequest, ImportMoviesResponse]) error
+	BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchGetMoviesResponse, error)
+	BatchCreateMovies(context.Context, *BatchCreateMoviesRequest) (*BatchCreateMoviesResponse, error)
+	BatchDeleteMovies(context.Context, *BatchDeleteMoviesRequest) (*BatchDeleteMoviesResponse, error)
+	WatchMovies(*WatchMoviesRequest, grpc.ServerStreamingServer[MovieEvent]) error
 	UpdateMovie(context.Context, *UpdateMovieRequest) (*UpdateMovieResponse, error)
 	DeleteMovie(context.Context, *DeleteMovieRequest) (*DeleteMovieResponse, error)
+	UndeleteMovie(context.Context, *UndeleteMovieRequest) (*UndeleteMovieResponse, error)
+	ListDeletedMovies(context.Context, *ListDeletedMoviesRequest) (*ListDeletedMoviesResponse, error)
+	PurgeMovie(context.Context, *PurgeMovieRequest) (*PurgeMovieResponse, error)
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	CreateGenre(context.Context, *CreateGenreRequest) (*CreateGenreResponse, error)
+	GetGenre(context.Context, *ReadGenreRequest) (*ReadGenreResponse, error)
+	GetGenres(context.Context, *ReadGenresRequest) (*ReadGenresResponse, error)
+	UpdateGenre(context.Context, *UpdateGenreRequest) (*UpdateGenreResponse, error)
+	DeleteGenre(context.Context, *DeleteGenreRequest) (*DeleteGenreResponse, error)
 	mustEmbedUnimplementedMovieServiceServer()
 }
 
@@ -123,12 +352,60 @@ func (UnimplementedMovieServiceServer) GetMovie(context.Context, *ReadMovieReque
 func (UnimplementedMovieServiceServer) GetMovies(context.Context, *ReadMoviesRequest) (*ReadMoviesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMovies not implemented")
 }
+func (UnimplementedMovieServiceServer) SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) StreamMovies(*StreamMoviesRequest, grpc.ServerStreamingServer[Movie]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) ImportMovies(grpc.ClientStreamingServer[ImportMoviesRequest, ImportMoviesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchGetMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) BatchCreateMovies(context.Context, *BatchCreateMoviesRequest) (*BatchCreateMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) BatchDeleteMovies(context.Context, *BatchDeleteMoviesRequest) (*BatchDeleteMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) WatchMovies(*WatchMoviesRequest, grpc.ServerStreamingServer[MovieEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMovies not implemented")
+}
 func (UnimplementedMovieServiceServer) UpdateMovie(context.Context, *UpdateMovieRequest) (*UpdateMovieResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMovie not implemented")
 }
 func (UnimplementedMovieServiceServer) DeleteMovie(context.Context, *DeleteMovieRequest) (*DeleteMovieResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
 }
+func (UnimplementedMovieServiceServer) UndeleteMovie(context.Context, *UndeleteMovieRequest) (*UndeleteMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) ListDeletedMovies(context.Context, *ListDeletedMoviesRequest) (*ListDeletedMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) PurgeMovie(context.Context, *PurgeMovieRequest) (*PurgeMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedMovieServiceServer) CreateGenre(context.Context, *CreateGenreRequest) (*CreateGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGenre not implemented")
+}
+func (UnimplementedMovieServiceServer) GetGenre(context.Context, *ReadGenreRequest) (*ReadGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenre not implemented")
+}
+func (UnimplementedMovieServiceServer) GetGenres(context.Context, *ReadGenresRequest) (*ReadGenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenres not implemented")
+}
+func (UnimplementedMovieServiceServer) UpdateGenre(context.Context, *UpdateGenreRequest) (*UpdateGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGenre not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteGenre(context.Context, *DeleteGenreRequest) (*DeleteGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
 func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
 func (UnimplementedMovieServiceServer) testEmbeddedByValue()                      {}
 
@@ -204,6 +481,107 @@ func _MovieService_GetMovies_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_SearchMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SearchMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SearchMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SearchMovies(ctx, req.(*SearchMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_StreamMovies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMoviesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MovieServiceServer).StreamMovies(m, &grpc.GenericServerStream[StreamMoviesRequest, Movie]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_StreamMoviesServer = grpc.ServerStreamingServer[Movie]
+
+func _MovieService_ImportMovies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MovieServiceServer).ImportMovies(&grpc.GenericServerStream[ImportMoviesRequest, ImportMoviesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_ImportMoviesServer = grpc.ClientStreamingServer[ImportMoviesRequest, ImportMoviesResponse]
+
+func _MovieService_BatchGetMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchGetMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_BatchGetMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchGetMovies(ctx, req.(*BatchGetMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_BatchCreateMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchCreateMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_BatchCreateMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchCreateMovies(ctx, req.(*BatchCreateMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_BatchDeleteMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchDeleteMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_BatchDeleteMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchDeleteMovies(ctx, req.(*BatchDeleteMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_WatchMovies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMoviesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MovieServiceServer).WatchMovies(m, &grpc.GenericServerStream[WatchMoviesRequest, MovieEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_WatchMoviesServer = grpc.ServerStreamingServer[MovieEvent]
+
 func _MovieService_UpdateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateMovieRequest)
 	if err := dec(in); err != nil {
@@ -240,32 +618,846 @@ func _MovieService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// MovieService_ServiceDesc is the grpc.ServiceDesc for MovieService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MovieService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.MovieService",
-	HandlerType: (*MovieServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMovie",
-			Handler:    _MovieService_CreateMovie_Handler,
-		},
-		{
-			MethodName: "GetMovie",
-			Handler:    _MovieService_GetMovie_Handler,
-		},
-		{
-			MethodName: "GetMovies",
-			Handler:    _MovieService_GetMovies_Handler,
-		},
-		{
-			MethodName: "UpdateMovie",
-			Handler:    _MovieService_UpdateMovie_Handler,
-		},
-		{
-			MethodName: "DeleteMovie",
-			Handler:    _MovieService_DeleteMovie_Handler,
+func _MovieService_UndeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UndeleteMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_UndeleteMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UndeleteMovie(ctx, req.(*UndeleteMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ListDeletedMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).ListDeletedMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_ListDeletedMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).ListDeletedMovies(ctx, req.(*ListDeletedMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_PurgeMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).PurgeMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_PurgeMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).PurgeMovie(ctx, req.(*PurgeMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_FindDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_CreateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).CreateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_CreateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).CreateGenre(ctx, req.(*CreateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetGenre(ctx, req.(*ReadGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetGenres(ctx, req.(*ReadGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_UpdateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UpdateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_UpdateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UpdateGenre(ctx, req.(*UpdateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteGenre(ctx, req.(*DeleteGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MovieService_ServiceDesc is the grpc.ServiceDesc for MovieService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MovieService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.MovieService",
+	HandlerType: (*MovieServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMovie",
+			Handler:    _MovieService_CreateMovie_Handler,
+		},
+		{
+			MethodName: "GetMovie",
+			Handler:    _MovieService_GetMovie_Handler,
+		},
+		{
+			MethodName: "GetMovies",
+			Handler:    _MovieService_GetMovies_Handler,
+		},
+		{
+			MethodName: "SearchMovies",
+			Handler:    _MovieService_SearchMovies_Handler,
+		},
+		{
+			MethodName: "BatchGetMovies",
+			Handler:    _MovieService_BatchGetMovies_Handler,
+		},
+		{
+			MethodName: "BatchCreateMovies",
+			Handler:    _MovieService_BatchCreateMovies_Handler,
+		},
+		{
+			MethodName: "BatchDeleteMovies",
+			Handler:    _MovieService_BatchDeleteMovies_Handler,
+		},
+		{
+			MethodName: "UpdateMovie",
+			Handler:    _MovieService_UpdateMovie_Handler,
+		},
+		{
+			MethodName: "DeleteMovie",
+			Handler:    _MovieService_DeleteMovie_Handler,
+		},
+		{
+			MethodName: "UndeleteMovie",
+			Handler:    _MovieService_UndeleteMovie_Handler,
+		},
+		{
+			MethodName: "ListDeletedMovies",
+			Handler:    _MovieService_ListDeletedMovies_Handler,
+		},
+		{
+			MethodName: "PurgeMovie",
+			Handler:    _MovieService_PurgeMovie_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _MovieService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "CreateGenre",
+			Handler:    _MovieService_CreateGenre_Handler,
+		},
+		{
+			MethodName: "GetGenre",
+			Handler:    _MovieService_GetGenre_Handler,
+		},
+		{
+			MethodName: "GetGenres",
+			Handler:    _MovieService_GetGenres_Handler,
+		},
+		{
+			MethodName: "UpdateGenre",
+			Handler:    _MovieService_UpdateGenre_Handler,
+		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _MovieService_DeleteGenre_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMovies",
+			Handler:       _MovieService_StreamMovies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportMovies",
+			Handler:       _MovieService_ImportMovies_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchMovies",
+			Handler:       _MovieService_WatchMovies_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "movie.proto",
+}
+
+const (
+	PeopleService_CreatePerson_FullMethodName    = "/proto.PeopleService/CreatePerson"
+	PeopleService_GetPerson_FullMethodName       = "/proto.PeopleService/GetPerson"
+	PeopleService_GetPeople_FullMethodName       = "/proto.PeopleService/GetPeople"
+	PeopleService_UpdatePerson_FullMethodName    = "/proto.PeopleService/UpdatePerson"
+	PeopleService_DeletePerson_FullMethodName    = "/proto.PeopleService/DeletePerson"
+	PeopleService_CreateCredit_FullMethodName    = "/proto.PeopleService/CreateCredit"
+	PeopleService_DeleteCredit_FullMethodName    = "/proto.PeopleService/DeleteCredit"
+	PeopleService_GetPersonMovies_FullMethodName = "/proto.PeopleService/GetPersonMovies"
+)
+
+// PeopleServiceClient is the client API for PeopleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PeopleServiceClient interface {
+	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error)
+	GetPerson(ctx context.Context, in *ReadPersonRequest, opts ...grpc.CallOption) (*ReadPersonResponse, error)
+	GetPeople(ctx context.Context, in *ReadPeopleRequest, opts ...grpc.CallOption) (*ReadPeopleResponse, error)
+	UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error)
+	DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*DeletePersonResponse, error)
+	CreateCredit(ctx context.Context, in *CreateCreditRequest, opts ...grpc.CallOption) (*CreateCreditResponse, error)
+	DeleteCredit(ctx context.Context, in *DeleteCreditRequest, opts ...grpc.CallOption) (*DeleteCreditResponse, error)
+	GetPersonMovies(ctx context.Context, in *ReadPersonMoviesRequest, opts ...grpc.CallOption) (*ReadPersonMoviesResponse, error)
+}
+
+type peopleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeopleServiceClient(cc grpc.ClientConnInterface) PeopleServiceClient {
+	return &peopleServiceClient{cc}
+}
+
+func (c *peopleServiceClient) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePersonResponse)
+	err := c.cc.Invoke(ctx, PeopleService_CreatePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) GetPerson(ctx context.Context, in *ReadPersonRequest, opts ...grpc.CallOption) (*ReadPersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadPersonResponse)
+	err := c.cc.Invoke(ctx, PeopleService_GetPerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) GetPeople(ctx context.Context, in *ReadPeopleRequest, opts ...grpc.CallOption) (*ReadPeopleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadPeopleResponse)
+	err := c.cc.Invoke(ctx, PeopleService_GetPeople_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePersonResponse)
+	err := c.cc.Invoke(ctx, PeopleService_UpdatePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*DeletePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePersonResponse)
+	err := c.cc.Invoke(ctx, PeopleService_DeletePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) CreateCredit(ctx context.Context, in *CreateCreditRequest, opts ...grpc.CallOption) (*CreateCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCreditResponse)
+	err := c.cc.Invoke(ctx, PeopleService_CreateCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) DeleteCredit(ctx context.Context, in *DeleteCreditRequest, opts ...grpc.CallOption) (*DeleteCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCreditResponse)
+	err := c.cc.Invoke(ctx, PeopleService_DeleteCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) GetPersonMovies(ctx context.Context, in *ReadPersonMoviesRequest, opts ...grpc.CallOption) (*ReadPersonMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadPersonMoviesResponse)
+	err := c.cc.Invoke(ctx, PeopleService_GetPersonMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeopleServiceServer is the server API for PeopleService service.
+// All implementations must embed UnimplementedPeopleServiceServer
+// for forward compatibility.
+type PeopleServiceServer interface {
+	CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error)
+	GetPerson(context.Context, *ReadPersonRequest) (*ReadPersonResponse, error)
+	GetPeople(context.Context, *ReadPeopleRequest) (*ReadPeopleResponse, error)
+	UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error)
+	DeletePerson(context.Context, *DeletePersonRequest) (*DeletePersonResponse, error)
+	CreateCredit(context.Context, *CreateCreditRequest) (*CreateCreditResponse, error)
+	DeleteCredit(context.Context, *DeleteCreditRequest) (*DeleteCreditResponse, error)
+	GetPersonMovies(context.Context, *ReadPersonMoviesRequest) (*ReadPersonMoviesResponse, error)
+	mustEmbedUnimplementedPeopleServiceServer()
+}
+
+// UnimplementedPeopleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPeopleServiceServer struct{}
+
+func (UnimplementedPeopleServiceServer) CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) GetPerson(context.Context, *ReadPersonRequest) (*ReadPersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) GetPeople(context.Context, *ReadPeopleRequest) (*ReadPeopleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeople not implemented")
+}
+func (UnimplementedPeopleServiceServer) UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) DeletePerson(context.Context, *DeletePersonRequest) (*DeletePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) CreateCredit(context.Context, *CreateCreditRequest) (*CreateCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredit not implemented")
+}
+func (UnimplementedPeopleServiceServer) DeleteCredit(context.Context, *DeleteCreditRequest) (*DeleteCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredit not implemented")
+}
+func (UnimplementedPeopleServiceServer) GetPersonMovies(context.Context, *ReadPersonMoviesRequest) (*ReadPersonMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonMovies not implemented")
+}
+func (UnimplementedPeopleServiceServer) mustEmbedUnimplementedPeopleServiceServer() {}
+func (UnimplementedPeopleServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePeopleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeopleServiceServer will
+// result in compilation errors.
+type UnsafePeopleServiceServer interface {
+	mustEmbedUnimplementedPeopleServiceServer()
+}
+
+func RegisterPeopleServiceServer(s grpc.ServiceRegistrar, srv PeopleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPeopleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PeopleService_ServiceDesc, srv)
+}
+
+func _PeopleService_CreatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).CreatePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_CreatePerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).CreatePerson(ctx, req.(*CreatePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).GetPerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_GetPerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).GetPerson(ctx, req.(*ReadPersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_GetPeople_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPeopleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).GetPeople(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_GetPeople_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).GetPeople(ctx, req.(*ReadPeopleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_UpdatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).UpdatePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_UpdatePerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).UpdatePerson(ctx, req.(*UpdatePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_DeletePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).DeletePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_DeletePerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).DeletePerson(ctx, req.(*DeletePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_CreateCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).CreateCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_CreateCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).CreateCredit(ctx, req.(*CreateCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_DeleteCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).DeleteCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_DeleteCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).DeleteCredit(ctx, req.(*DeleteCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_GetPersonMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPersonMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).GetPersonMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_GetPersonMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).GetPersonMovies(ctx, req.(*ReadPersonMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PeopleService_ServiceDesc is the grpc.ServiceDesc for PeopleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PeopleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.PeopleService",
+	HandlerType: (*PeopleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePerson",
+			Handler:    _PeopleService_CreatePerson_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _PeopleService_GetPerson_Handler,
+		},
+		{
+			MethodName: "GetPeople",
+			Handler:    _PeopleService_GetPeople_Handler,
+		},
+		{
+			MethodName: "UpdatePerson",
+			Handler:    _PeopleService_UpdatePerson_Handler,
+		},
+		{
+			MethodName: "DeletePerson",
+			Handler:    _PeopleService_DeletePerson_Handler,
+		},
+		{
+			MethodName: "CreateCredit",
+			Handler:    _PeopleService_CreateCredit_Handler,
+		},
+		{
+			MethodName: "DeleteCredit",
+			Handler:    _PeopleService_DeleteCredit_Handler,
+		},
+		{
+			MethodName: "GetPersonMovies",
+			Handler:    _PeopleService_GetPersonMovies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "movie.proto",
+}
+
+const (
+	ReviewService_CreateReview_FullMethodName = "/proto.ReviewService/CreateReview"
+	ReviewService_UpdateReview_FullMethodName = "/proto.ReviewService/UpdateReview"
+	ReviewService_DeleteReview_FullMethodName = "/proto.ReviewService/DeleteReview"
+	ReviewService_GetReviews_FullMethodName   = "/proto.ReviewService/GetReviews"
+)
+
+// ReviewServiceClient is the client API for ReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewServiceClient interface {
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewResponse, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	GetReviews(ctx context.Context, in *ReadReviewsRequest, opts ...grpc.CallOption) (*ReadReviewsResponse, error)
+}
+
+type reviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewServiceClient(cc grpc.ClientConnInterface) ReviewServiceClient {
+	return &reviewServiceClient{cc}
+}
+
+func (c *reviewServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetReviews(ctx context.Context, in *ReadReviewsRequest, opts ...grpc.CallOption) (*ReadReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewServiceServer is the server API for ReviewService service.
+// All implementations must embed UnimplementedReviewServiceServer
+// for forward compatibility.
+type ReviewServiceServer interface {
+	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	GetReviews(context.Context, *ReadReviewsRequest) (*ReadReviewsResponse, error)
+	mustEmbedUnimplementedReviewServiceServer()
+}
+
+// UnimplementedReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewServiceServer struct{}
+
+func (UnimplementedReviewServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedReviewServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewServiceServer) GetReviews(context.Context, *ReadReviewsRequest) (*ReadReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviews not implemented")
+}
+func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
+func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewServiceServer will
+// result in compilation errors.
+type UnsafeReviewServiceServer interface {
+	mustEmbedUnimplementedReviewServiceServer()
+}
+
+func RegisterReviewServiceServer(s grpc.ServiceRegistrar, srv ReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewService_ServiceDesc, srv)
+}
+
+func _ReviewService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetReviews(ctx, req.(*ReadReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewService_ServiceDesc is the grpc.ServiceDesc for ReviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ReviewService",
+	HandlerType: (*ReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReview",
+			Handler:    _ReviewService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ReviewService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ReviewService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "GetReviews",
+			Handler:    _ReviewService_GetReviews_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
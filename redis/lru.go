@@ -0,0 +1,206 @@
+package mencache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Single movies and list pages share a keyspace, so each gets its own prefix:
+// no movie id can name a list page, whatever characters it contains.
+const (
+	movieKeyPrefix     = "movie:id:"
+	movieListKeyPrefix = "movie:list:"
+)
+
+type lruEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+type lruMovieCache struct {
+	mu         sync.Mutex
+	capacity   int
+	expiration time.Duration
+	ll         *list.List
+	items      map[string]*list.Element
+	version    int64
+}
+
+// NewLRUMovieServiceCache returns an in-process cache holding at most capacity
+// entries, evicting the least recently used one first. Entries also expire
+// after expiration. A capacity of zero or less means unbounded.
+func NewLRUMovieServiceCache(capacity int, expiration time.Duration) MovieServiceCache {
+	return &lruMovieCache{
+		capacity:   capacity,
+		expiration: expiration,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+func (c *lruMovieCache) key(id string) string {
+	return movieKeyPrefix + id
+}
+
+func (c *lruMovieCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruEntry)
+	if c.expiration > 0 && time.Now().After(entry.expiresAt) {
+		c.removeElement(el)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+func (c *lruMovieCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.expiration)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	if c.capacity > 0 && c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+func (c *lruMovieCache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+func (c *lruMovieCache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry).key)
+}
+
+// getMovie returns the movie cached under id. Anything else stored there is
+// a miss rather than a panic.
+func (c *lruMovieCache) getMovie(id string) (*pb.Movie, bool) {
+	value, ok := c.get(c.key(id))
+	if !ok {
+		return nil, false
+	}
+	movie, ok := value.(*pb.Movie)
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(movie).(*pb.Movie), true
+}
+
+func (c *lruMovieCache) GetMovie(ctx context.Context, id string) (*pb.Movie, error) {
+	movie, _ := c.getMovie(id)
+	return movie, nil
+}
+
+func (c *lruMovieCache) GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error) {
+	movies := make(map[string]*pb.Movie, len(ids))
+	for _, id := range ids {
+		if movie, ok := c.getMovie(id); ok {
+			movies[id] = movie
+		}
+	}
+	return movies, nil
+}
+
+func (c *lruMovieCache) SetMovie(ctx context.Context, movie *pb.Movie) error {
+	c.set(c.key(movie.GetId()), proto.Clone(movie))
+	return nil
+}
+
+func (c *lruMovieCache) FillMovie(ctx context.Context, movie *pb.Movie) error {
+	return c.SetMovie(ctx, movie)
+}
+
+func (c *lruMovieCache) DeleteMovie(ctx context.Context, id string) error {
+	c.delete(c.key(id))
+	return nil
+}
+
+func (c *lruMovieCache) GetMovieList(ctx context.Context, key string) (*dto.MovieListResult, error) {
+	value, ok := c.get(key)
+	if !ok {
+		return nil, nil
+	}
+	result, ok := value.(*dto.MovieListResult)
+	if !ok {
+		return nil, nil
+	}
+	return cloneMovieList(result), nil
+}
+
+func (c *lruMovieCache) SetMovieList(ctx context.Context, key string, result *dto.MovieListResult) error {
+	c.set(key, cloneMovieList(result))
+	return nil
+}
+
+func (c *lruMovieCache) DeleteMovieList(ctx context.Context, key string) error {
+	c.delete(key)
+	return nil
+}
+
+func (c *lruMovieCache) GetMovieListVersion(ctx context.Context) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.version, nil
+}
+
+// InvalidateMovieLists bumps the local list version and drops every cached
+// page right away, since none of them can be addressed any more.
+func (c *lruMovieCache) InvalidateMovieLists(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	for key, el := range c.items {
+		if strings.HasPrefix(key, movieListKeyPrefix) {
+			c.removeElement(el)
+		}
+	}
+	return nil
+}
+
+func cloneMovieList(result *dto.MovieListResult) *dto.MovieListResult {
+	movies := make([]*pb.Movie, len(result.Movies))
+	for i, m := range result.Movies {
+		movies[i] = proto.Clone(m).(*pb.Movie)
+	}
+	return &dto.MovieListResult{
+		Movies:        movies,
+		TotalRecords:  result.TotalRecords,
+		NextPageToken: result.NextPageToken,
+	}
+}
+
+// NewInMemoryMovieServiceCache returns an unbounded process-local cache. It
+// needs no Redis and is meant for tests and local development.
+func NewInMemoryMovieServiceCache(expiration time.Duration) MovieServiceCache {
+	return NewLRUMovieServiceCache(0, expiration)
+}
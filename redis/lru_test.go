@@ -0,0 +1,168 @@
+package mencache
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+)
+
+// cached reports which of ids the cache holds.
+func cached(t *testing.T, cache MovieServiceCache, ids ...string) map[string]bool {
+	t.Helper()
+
+	held := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		movie, err := cache.GetMovie(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		held[id] = movie != nil
+	}
+	return held
+}
+
+func TestLRUEviction(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		// touch is read between filling the cache and overflowing it.
+		touch string
+		want  map[string]bool
+	}{
+		{
+			name: "oldest goes first",
+			want: map[string]bool{"a": false, "b": true, "c": true, "d": true},
+		},
+		{
+			name:  "a read keeps an entry",
+			touch: "a",
+			want:  map[string]bool{"a": true, "b": false, "c": true, "d": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewLRUMovieServiceCache(3, time.Minute)
+			for _, id := range []string{"a", "b", "c"} {
+				cache.SetMovie(ctx, &pb.Movie{Id: id})
+			}
+			if tt.touch != "" {
+				cached(t, cache, tt.touch)
+			}
+			cache.SetMovie(ctx, &pb.Movie{Id: "d"})
+
+			got := cached(t, cache, "a", "b", "c", "d")
+			for id, want := range tt.want {
+				if got[id] != want {
+					t.Errorf("holds %s = %v, want %v", id, got[id], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLRUOverwriteDoesNotEvict(t *testing.T) {
+	ctx := context.Background()
+	cache := NewLRUMovieServiceCache(2, time.Minute)
+	cache.SetMovie(ctx, &pb.Movie{Id: "a", Title: "old"})
+	cache.SetMovie(ctx, &pb.Movie{Id: "b"})
+	cache.SetMovie(ctx, &pb.Movie{Id: "a", Title: "new"})
+
+	if got := cached(t, cache, "a", "b"); !got["a"] || !got["b"] {
+		t.Fatalf("holds %v, want both", got)
+	}
+	movie, _ := cache.GetMovie(ctx, "a")
+	if movie.GetTitle() != "new" {
+		t.Errorf("title = %q, want new", movie.GetTitle())
+	}
+}
+
+func TestLRUExpiry(t *testing.T) {
+	ctx := context.Background()
+	cache := NewLRUMovieServiceCache(0, 20*time.Millisecond)
+	cache.SetMovie(ctx, &pb.Movie{Id: "a"})
+	cache.SetMovieList(ctx, movieListKeyPrefix+"1", &dto.MovieListResult{TotalRecords: 1})
+
+	if got := cached(t, cache, "a"); !got["a"] {
+		t.Fatal("entry missing before it expired")
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	if got := cached(t, cache, "a"); got["a"] {
+		t.Error("movie still cached after it expired")
+	}
+	if list, _ := cache.GetMovieList(ctx, movieListKeyPrefix+"1"); list != nil {
+		t.Error("list still cached after it expired")
+	}
+}
+
+func TestLRUReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	cache := NewInMemoryMovieServiceCache(time.Minute)
+
+	movie := &pb.Movie{Id: "a", Title: "Heat"}
+	cache.SetMovie(ctx, movie)
+	movie.Title = "changed after set"
+
+	got, _ := cache.GetMovie(ctx, "a")
+	got.Title = "changed after get"
+
+	if again, _ := cache.GetMovie(ctx, "a"); again.GetTitle() != "Heat" {
+		t.Errorf("title = %q, want Heat", again.GetTitle())
+	}
+}
+
+func TestLRUInvalidateMovieLists(t *testing.T) {
+	ctx := context.Background()
+	cache := NewInMemoryMovieServiceCache(time.Minute)
+	cache.SetMovie(ctx, &pb.Movie{Id: "a"})
+	cache.SetMovieList(ctx, movieListKeyPrefix+"0:page", &dto.MovieListResult{TotalRecords: 1})
+
+	before, _ := cache.GetMovieListVersion(ctx)
+	if err := cache.InvalidateMovieLists(ctx); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := cache.GetMovieListVersion(ctx)
+
+	if after != before+1 {
+		t.Errorf("list version went from %d to %d, want one bump", before, after)
+	}
+	if list, _ := cache.GetMovieList(ctx, movieListKeyPrefix+"0:page"); list != nil {
+		t.Error("list still cached after invalidation")
+	}
+	if got := cached(t, cache, "a"); !got["a"] {
+		t.Error("invalidating lists dropped a movie")
+	}
+}
+
+func TestLRUMovieIDsCannotNameListPages(t *testing.T) {
+	ctx := context.Background()
+	cache := NewInMemoryMovieServiceCache(time.Minute)
+
+	listKey := movieListKeyPrefix + "v=1:page=1:size=10"
+	listID := strings.TrimPrefix(listKey, "movie:")
+	cache.SetMovieList(ctx, listKey, &dto.MovieListResult{TotalRecords: 3})
+
+	movie, err := cache.GetMovie(ctx, listID)
+	if err != nil || movie != nil {
+		t.Fatalf("GetMovie(%q) = %v, %v, want a miss", listID, movie, err)
+	}
+	movies, err := cache.GetMoviesByID(ctx, []string{listID})
+	if err != nil || len(movies) != 0 {
+		t.Fatalf("GetMoviesByID(%q) = %v, %v, want no movies", listID, movies, err)
+	}
+
+	// Caching a movie under that id leaves the page alone.
+	cache.SetMovie(ctx, &pb.Movie{Id: listID, Title: "Heat"})
+	list, err := cache.GetMovieList(ctx, listKey)
+	if err != nil || list == nil || list.TotalRecords != 3 {
+		t.Errorf("GetMovieList(%q) = %v, %v, want the cached page", listKey, list, err)
+	}
+	if movie, _ := cache.GetMovie(ctx, listID); movie.GetTitle() != "Heat" {
+		t.Errorf("GetMovie(%q) = %v, want the cached movie", listID, movie)
+	}
+}
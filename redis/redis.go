@@ -3,7 +3,8 @@ package mencache
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"math"
+	"math/rand"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -14,32 +15,96 @@ import (
 
 type MovieServiceCache interface {
 	GetMovie(ctx context.Context, id string) (*pb.Movie, error)
+	// GetMoviesByID looks up several movies in one round trip. Misses are
+	// simply absent from the returned map.
+	GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error)
+	// SetMovie stores a movie that was just written, and FillMovie one that
+	// was just read. Only SetMovie tells other replicas their copy is stale.
 	SetMovie(ctx context.Context, movie *pb.Movie) error
+	FillMovie(ctx context.Context, movie *pb.Movie) error
 	DeleteMovie(ctx context.Context, id string) error
 
 	GetMovieList(ctx context.Context, key string) (*dto.MovieListResult, error)
 	SetMovieList(ctx context.Context, key string, result *dto.MovieListResult) error
 	DeleteMovieList(ctx context.Context, key string) error
+
+	// GetMovieListVersion returns the current generation of cached movie
+	// lists. It is folded into list keys so bumping it retires every page.
+	GetMovieListVersion(ctx context.Context) (int64, error)
+	InvalidateMovieLists(ctx context.Context) error
 }
 
+const movieListVersionKey = "movie:list:version"
+
 type redisMovieCache struct {
 	client     *redis.Client
 	expiration time.Duration
+
+	refreshDelta time.Duration
+	refreshBeta  float64
 }
 
-func NewMovieServiceCache(client *redis.Client, expiration time.Duration) MovieServiceCache {
-	return &redisMovieCache{
+type Option func(*redisMovieCache)
+
+// WithEarlyRefresh enables probabilistic early expiration (XFetch). As an
+// entry approaches its TTL, reads report a miss with growing probability so
+// that one caller recomputes it while everyone else is still served the
+// cached value. delta is roughly how long a recompute takes; beta > 1 favours
+// earlier refreshes.
+func WithEarlyRefresh(delta time.Duration, beta float64) Option {
+	return func(r *redisMovieCache) {
+		r.refreshDelta = delta
+		r.refreshBeta = beta
+	}
+}
+
+func NewMovieServiceCache(client *redis.Client, expiration time.Duration, opts ...Option) MovieServiceCache {
+	r := &redisMovieCache{
 		client:     client,
 		expiration: expiration,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 func (r *redisMovieCache) key(id string) string {
-	return fmt.Sprintf("movie:%s", id)
+	return movieKeyPrefix + id
+}
+
+func (r *redisMovieCache) get(ctx context.Context, key string) (string, error) {
+	if r.refreshDelta <= 0 {
+		return r.client.Get(ctx, key).Result()
+	}
+
+	pipe := r.client.Pipeline()
+	getCmd := pipe.Get(ctx, key)
+	ttlCmd := pipe.PTTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return "", err
+	}
+
+	val, err := getCmd.Result()
+	if err != nil {
+		return "", err
+	}
+	if r.shouldRefreshEarly(ttlCmd.Val()) {
+		return "", redis.Nil
+	}
+	return val, nil
+}
+
+func (r *redisMovieCache) shouldRefreshEarly(ttl time.Duration) bool {
+	if ttl <= 0 {
+		return false
+	}
+	gap := -float64(r.refreshDelta) * r.refreshBeta * math.Log(rand.Float64())
+	return gap >= float64(ttl)
 }
 
 func (r *redisMovieCache) GetMovie(ctx context.Context, id string) (*pb.Movie, error) {
-	val, err := r.client.Get(ctx, r.key(id)).Result()
+	val, err := r.get(ctx, r.key(id))
 	if err == redis.Nil {
 		return nil, nil
 	}
@@ -55,6 +120,37 @@ func (r *redisMovieCache) GetMovie(ctx context.Context, id string) (*pb.Movie, e
 	return &movie, nil
 }
 
+// GetMoviesByID reads every movie with a single MGET. Early refresh does not
+// apply: batch reads are never the ones left to recompute a hot entry.
+func (r *redisMovieCache) GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = r.key(id)
+	}
+
+	vals, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	movies := make(map[string]*pb.Movie, len(ids))
+	for i, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+		var movie pb.Movie
+		if err := protojson.Unmarshal([]byte(s), &movie); err != nil {
+			return nil, err
+		}
+		movies[ids[i]] = &movie
+	}
+	return movies, nil
+}
+
 func (r *redisMovieCache) SetMovie(ctx context.Context, movie *pb.Movie) error {
 	jsonData, err := protojson.Marshal(movie)
 	if err != nil {
@@ -64,12 +160,16 @@ func (r *redisMovieCache) SetMovie(ctx context.Context, movie *pb.Movie) error {
 	return r.client.Set(ctx, r.key(movie.Id), jsonData, r.expiration).Err()
 }
 
+func (r *redisMovieCache) FillMovie(ctx context.Context, movie *pb.Movie) error {
+	return r.SetMovie(ctx, movie)
+}
+
 func (r *redisMovieCache) DeleteMovie(ctx context.Context, id string) error {
 	return r.client.Del(ctx, r.key(id)).Err()
 }
 
 func (r *redisMovieCache) GetMovieList(ctx context.Context, key string) (*dto.MovieListResult, error) {
-	val, err := r.client.Get(ctx, key).Result()
+	val, err := r.get(ctx, key)
 	if err == redis.Nil {
 		return nil, nil
 	}
@@ -77,15 +177,11 @@ func (r *redisMovieCache) GetMovieList(ctx context.Context, key string) (*dto.Mo
 		return nil, err
 	}
 
-	var result dto.MovieListResult
-	if err := json.Unmarshal([]byte(val), &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+	return unmarshalMovieList([]byte(val))
 }
 
 func (r *redisMovieCache) SetMovieList(ctx context.Context, key string, result *dto.MovieListResult) error {
-	data, err := json.Marshal(result)
+	data, err := marshalMovieList(result)
 	if err != nil {
 		return err
 	}
@@ -95,3 +191,64 @@ func (r *redisMovieCache) SetMovieList(ctx context.Context, key string, result *
 func (r *redisMovieCache) DeleteMovieList(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
 }
+
+func (r *redisMovieCache) GetMovieListVersion(ctx context.Context) (int64, error) {
+	version, err := r.client.Get(ctx, movieListVersionKey).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (r *redisMovieCache) InvalidateMovieLists(ctx context.Context) error {
+	return r.client.Incr(ctx, movieListVersionKey).Err()
+}
+
+// movieList is the cached form of a dto.MovieListResult. Movies are encoded
+// with protojson, the same as single movies, so timestamps and enums survive
+// the round trip.
+type movieList struct {
+	Movies        []json.RawMessage `json:"movies"`
+	TotalRecords  int64             `json:"total_records"`
+	NextPageToken string            `json:"next_page_token,omitempty"`
+}
+
+func marshalMovieList(result *dto.MovieListResult) ([]byte, error) {
+	list := movieList{
+		Movies:        make([]json.RawMessage, len(result.Movies)),
+		TotalRecords:  result.TotalRecords,
+		NextPageToken: result.NextPageToken,
+	}
+	for i, m := range result.Movies {
+		data, err := protojson.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		list.Movies[i] = data
+	}
+	return json.Marshal(list)
+}
+
+func unmarshalMovieList(data []byte) (*dto.MovieListResult, error) {
+	var list movieList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	result := &dto.MovieListResult{
+		Movies:        make([]*pb.Movie, len(list.Movies)),
+		TotalRecords:  list.TotalRecords,
+		NextPageToken: list.NextPageToken,
+	}
+	for i, raw := range list.Movies {
+		var movie pb.Movie
+		if err := protojson.Unmarshal(raw, &movie); err != nil {
+			return nil, err
+		}
+		result.Movies[i] = &movie
+	}
+	return result, nil
+}
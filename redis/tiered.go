@@ -0,0 +1,167 @@
+package mencache
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	"github.com/renaldyhidayatt/movie_grpc/logger"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"go.uber.org/zap"
+)
+
+const movieInvalidationChannel = "movie:invalidate"
+
+type tieredMovieCache struct {
+	l1     MovieServiceCache
+	l2     MovieServiceCache
+	client *redis.Client
+	logger logger.LoggerInterface
+	nodeID string
+}
+
+// NewTieredMovieCache chains an in-process l1 cache in front of the shared l2
+// cache. Single-movie writes, through SetMovie and DeleteMovie, are announced
+// over Redis pub/sub so every other replica evicts its l1 copy; the
+// subscription lives until ctx is done.
+//
+// Invalidations published while a replica is disconnected from Redis are
+// lost, so the l1 expiration bounds how stale a replica can get.
+func NewTieredMovieCache(ctx context.Context, l1, l2 MovieServiceCache, client *redis.Client, logger logger.LoggerInterface) MovieServiceCache {
+	t := &tieredMovieCache{
+		l1:     l1,
+		l2:     l2,
+		client: client,
+		logger: logger,
+		nodeID: uuid.New().String(),
+	}
+	go t.subscribe(ctx)
+	return t
+}
+
+func (t *tieredMovieCache) subscribe(ctx context.Context) {
+	sub := t.client.Subscribe(ctx, movieInvalidationChannel)
+	defer sub.Close()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			nodeID, id, found := strings.Cut(msg.Payload, " ")
+			if !found || nodeID == t.nodeID {
+				continue
+			}
+			_ = t.l1.DeleteMovie(ctx, id)
+		}
+	}
+}
+
+func (t *tieredMovieCache) publish(ctx context.Context, id string) {
+	if err := t.client.Publish(ctx, movieInvalidationChannel, t.nodeID+" "+id).Err(); err != nil {
+		t.logger.Warn("failed to publish movie cache invalidation",
+			zap.String("movie.id", id),
+			zap.Error(err),
+		)
+	}
+}
+
+func (t *tieredMovieCache) GetMovie(ctx context.Context, id string) (*pb.Movie, error) {
+	if movie, _ := t.l1.GetMovie(ctx, id); movie != nil {
+		return movie, nil
+	}
+
+	movie, err := t.l2.GetMovie(ctx, id)
+	if err != nil || movie == nil {
+		return nil, err
+	}
+	_ = t.l1.SetMovie(ctx, movie)
+	return movie, nil
+}
+
+// GetMoviesByID serves what it can from l1 and asks l2 for the rest in one
+// call, copying those hits into l1.
+func (t *tieredMovieCache) GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error) {
+	movies, _ := t.l1.GetMoviesByID(ctx, ids)
+	if movies == nil {
+		movies = make(map[string]*pb.Movie, len(ids))
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if _, ok := movies[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return movies, nil
+	}
+
+	found, err := t.l2.GetMoviesByID(ctx, missing)
+	for id, movie := range found {
+		_ = t.l1.SetMovie(ctx, movie)
+		movies[id] = movie
+	}
+	return movies, err
+}
+
+func (t *tieredMovieCache) SetMovie(ctx context.Context, movie *pb.Movie) error {
+	_ = t.l1.SetMovie(ctx, movie)
+	err := t.l2.SetMovie(ctx, movie)
+	t.publish(ctx, movie.GetId())
+	return err
+}
+
+// FillMovie caches a movie read from the repository. Nothing changed, so other
+// replicas keep their l1 copies.
+func (t *tieredMovieCache) FillMovie(ctx context.Context, movie *pb.Movie) error {
+	_ = t.l1.SetMovie(ctx, movie)
+	return t.l2.FillMovie(ctx, movie)
+}
+
+func (t *tieredMovieCache) DeleteMovie(ctx context.Context, id string) error {
+	_ = t.l1.DeleteMovie(ctx, id)
+	err := t.l2.DeleteMovie(ctx, id)
+	t.publish(ctx, id)
+	return err
+}
+
+func (t *tieredMovieCache) GetMovieList(ctx context.Context, key string) (*dto.MovieListResult, error) {
+	if result, _ := t.l1.GetMovieList(ctx, key); result != nil {
+		return result, nil
+	}
+
+	result, err := t.l2.GetMovieList(ctx, key)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	_ = t.l1.SetMovieList(ctx, key, result)
+	return result, nil
+}
+
+func (t *tieredMovieCache) SetMovieList(ctx context.Context, key string, result *dto.MovieListResult) error {
+	_ = t.l1.SetMovieList(ctx, key, result)
+	return t.l2.SetMovieList(ctx, key, result)
+}
+
+func (t *tieredMovieCache) DeleteMovieList(ctx context.Context, key string) error {
+	_ = t.l1.DeleteMovieList(ctx, key)
+	return t.l2.DeleteMovieList(ctx, key)
+}
+
+// GetMovieListVersion always asks l2: list keys carry the shared version, so a
+// bump on any replica makes every replica's l1 pages unreachable.
+func (t *tieredMovieCache) GetMovieListVersion(ctx context.Context) (int64, error) {
+	return t.l2.GetMovieListVersion(ctx)
+}
+
+func (t *tieredMovieCache) InvalidateMovieLists(ctx context.Context) error {
+	_ = t.l1.InvalidateMovieLists(ctx)
+	return t.l2.InvalidateMovieLists(ctx)
+}
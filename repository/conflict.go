@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/gorm"
+)
+
+// conflictError wraps err in ErrConflict when SQLite refused a write because
+// of a concurrent one: the database was locked by another connection, or a
+// unique index was filled by a write that committed after this one checked
+// it. Any other error is returned as it is.
+func conflictError(err error) error {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+	switch {
+	case sqliteErr.Code == sqlite3.ErrBusy,
+		sqliteErr.Code == sqlite3.ErrLocked,
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique,
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey:
+		return fmt.Errorf("%w: %w", ErrConflict, err)
+	}
+	return err
+}
+
+// transaction runs fn in a transaction on db and reports concurrent writes
+// that got in its way as ErrConflict.
+func transaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return conflictError(db.WithContext(ctx).Transaction(fn))
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestConflictError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		conflict bool
+	}{
+		{"busy", sqlite3.Error{Code: sqlite3.ErrBusy}, true},
+		{"locked", sqlite3.Error{Code: sqlite3.ErrLocked}, true},
+		{"unique index", sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}, true},
+		{"primary key", sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintPrimaryKey}, true},
+		{"foreign key", sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintForeignKey}, false},
+		{"other sqlite error", sqlite3.Error{Code: sqlite3.ErrCorrupt}, false},
+		{"not from sqlite", errors.New("boom"), false},
+		{"version mismatch", ErrMovieVersionMismatch, false},
+	}
+	for _, tt := range tests {
+		err := conflictError(tt.err)
+		if got := errors.Is(err, ErrConflict); got != tt.conflict {
+			t.Errorf("%s: conflict = %v, want %v", tt.name, got, tt.conflict)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: %v no longer wraps %v", tt.name, err, tt.err)
+		}
+	}
+	if errors.Is(ErrMovieVersionMismatch, ErrConflict) {
+		t.Error("a version mismatch is a failed precondition, not a conflict")
+	}
+}
+
+func TestLockedDatabaseIsConflict(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "movies.db")
+	open := func() *gorm.DB {
+		// No busy timeout, so the locked write fails straight away.
+		db, err := gorm.Open(sqlite.Open(path+"?_busy_timeout=0"), &gorm.Config{
+			NowFunc: Now,
+			Logger:  logger.Default.LogMode(logger.Silent),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			if sqlDB, err := db.DB(); err == nil {
+				sqlDB.Close()
+			}
+		})
+		return db
+	}
+	holder, writer := open(), open()
+	if err := holder.AutoMigrate(&models.Movie{}, &models.Genre{}, &models.MovieEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MigrateMovieTitles(holder); err != nil {
+		t.Fatal(err)
+	}
+
+	lock := holder.Begin()
+	if err := lock.Exec("INSERT INTO genres (id, name, slug) VALUES ('g1', 'Crime', 'crime')").Error; err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Rollback()
+
+	err := NewMovieRepository(writer).CreateMovie(ctx, &pb.Movie{Title: "Heat"})
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("CreateMovie on a locked database = %v, want ErrConflict", err)
+	}
+}
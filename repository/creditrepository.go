@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"gorm.io/gorm"
+)
+
+var ErrCreditNotFound = newResourceError(ErrNotFound, "credit", "credit not found")
+
+type CreditRepository interface {
+	CreateCredit(ctx context.Context, credit *pb.Credit) error
+	DeleteCredit(ctx context.Context, id string) error
+	GetMovieCredits(ctx context.Context, movieID string) ([]*pb.Credit, error)
+	GetPersonMovies(ctx context.Context, personID string, role pb.CreditRole) ([]*pb.PersonMovie, error)
+}
+
+type creditRepository struct {
+	db *gorm.DB
+}
+
+func NewCreditRepository(db *gorm.DB) CreditRepository {
+	return &creditRepository{
+		db: db,
+	}
+}
+
+func (r *creditRepository) CreateCredit(ctx context.Context, credit *pb.Credit) error {
+	credit.Id = uuid.New().String()
+	data := creditFromProto(credit)
+
+	return transaction(ctx, r.db, func(tx *gorm.DB) error {
+		var movie models.Movie
+		if res := tx.Select("id").Find(&movie, "id = ?", data.MovieID); res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return ErrMovieNotFound
+		}
+		var person models.Person
+		if res := tx.Find(&person, "id = ?", data.PersonID); res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return ErrPersonNotFound
+		}
+
+		res := tx.Omit("Movie", "Person").Create(data)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errors.New("credit creation unsuccessful")
+		}
+		credit.PersonName = person.Name
+		return nil
+	})
+}
+
+func (r *creditRepository) DeleteCredit(ctx context.Context, id string) error {
+	res := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Credit{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrCreditNotFound
+	}
+	return nil
+}
+
+// GetMovieCredits returns the credits of a movie in billing order.
+func (r *creditRepository) GetMovieCredits(ctx context.Context, movieID string) ([]*pb.Credit, error) {
+	var credits []*models.Credit
+	err := r.db.WithContext(ctx).
+		Preload("Person").
+		Where("movie_id = ?", movieID).
+		Order("billing_order, id").
+		Find(&credits).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pbCredits := make([]*pb.Credit, len(credits))
+	for i, c := range credits {
+		pbCredits[i] = creditToProto(c)
+	}
+	return pbCredits, nil
+}
+
+// GetPersonMovies returns every movie the person is credited on, newest
+// release first, with one entry per credit.
+func (r *creditRepository) GetPersonMovies(ctx context.Context, personID string, role pb.CreditRole) ([]*pb.PersonMovie, error) {
+	var person models.Person
+	if res := r.db.WithContext(ctx).Find(&person, "id = ?", personID); res.Error != nil {
+		return nil, res.Error
+	} else if res.RowsAffected == 0 {
+		return nil, ErrPersonNotFound
+	}
+
+	query := r.db.WithContext(ctx).
+		Joins("JOIN movies ON movies.id = credits.movie_id AND movies.deleted_at IS NULL").
+		Preload("Movie.Genres").
+		Preload("Person").
+		Where("credits.person_id = ?", personID)
+	if role != pb.CreditRole_CREDIT_ROLE_UNSPECIFIED {
+		query = query.Where("credits.role = ?", role.String())
+	}
+
+	var credits []*models.Credit
+	if err := query.Order("movies.release_year DESC, movies.title").Find(&credits).Error; err != nil {
+		return nil, err
+	}
+
+	movies := make([]*pb.PersonMovie, len(credits))
+	for i, c := range credits {
+		movies[i] = &pb.PersonMovie{
+			Movie:  movieToProto(&c.Movie),
+			Credit: creditToProto(c),
+		}
+	}
+	return movies, nil
+}
+
+func creditToProto(c *models.Credit) *pb.Credit {
+	return &pb.Credit{
+		Id:            c.ID,
+		MovieId:       c.MovieID,
+		PersonId:      c.PersonID,
+		PersonName:    c.Person.Name,
+		Role:          pb.CreditRole(pb.CreditRole_value[c.Role]),
+		CharacterName: c.CharacterName,
+		BillingOrder:  c.BillingOrder,
+	}
+}
+
+func creditFromProto(credit *pb.Credit) *models.Credit {
+	c := &models.Credit{
+		ID:            credit.GetId(),
+		MovieID:       credit.GetMovieId(),
+		PersonID:      credit.GetPersonId(),
+		CharacterName: credit.GetCharacterName(),
+		BillingOrder:  credit.GetBillingOrder(),
+	}
+	if credit.GetRole() != pb.CreditRole_CREDIT_ROLE_UNSPECIFIED {
+		c.Role = credit.GetRole().String()
+	}
+	return c
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+)
+
+var ErrInvalidPageToken = newFieldError("page_token", "invalid page token")
+
+// movieCursor is the decoded form of a page token. It holds the sort key of
+// the last movie on the previous page and a fingerprint of the query the
+// token was issued for, so a token cannot be replayed against other filters.
+type movieCursor struct {
+	Query string          `json:"q"`
+	Value json.RawMessage `json:"v"`
+	ID    string          `json:"id"`
+}
+
+// encodeMovieCursor returns the page token that continues params after m.
+func encodeMovieCursor(params dto.MovieListParams, order MovieOrder, m *models.Movie) (string, error) {
+	value, err := json.Marshal(movieSortValue(m, order.Key))
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(movieCursor{
+		Query: movieQueryFingerprint(params, order),
+		Value: value,
+		ID:    m.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeMovieCursor parses a page token into a movie carrying only the ID and
+// the field order sorts on, ready to be compared against listed movies.
+func decodeMovieCursor(token string, params dto.MovieListParams, order MovieOrder) (*models.Movie, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var cursor movieCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	if cursor.Query != movieQueryFingerprint(params, order) {
+		return nil, fmt.Errorf("%w: it was issued for a different query", ErrInvalidPageToken)
+	}
+
+	m := &models.Movie{ID: cursor.ID}
+	var dest interface{}
+	switch order.Key {
+	case "title":
+		dest = &m.Title
+	case "release_year":
+		dest = &m.ReleaseYear
+	case "runtime_minutes":
+		dest = &m.RuntimeMinutes
+	case "created_at":
+		dest = &m.CreatedAt
+	case "updated_at":
+		dest = &m.UpdatedAt
+	case "rating":
+		dest = &m.AverageRating
+	}
+	if err := json.Unmarshal(cursor.Value, dest); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return m, nil
+}
+
+// movieSortValue returns the value m sorts by under key.
+func movieSortValue(m *models.Movie, key string) interface{} {
+	switch key {
+	case "title":
+		return m.Title
+	case "release_year":
+		return m.ReleaseYear
+	case "runtime_minutes":
+		return m.RuntimeMinutes
+	case "created_at":
+		return m.CreatedAt
+	case "updated_at":
+		return m.UpdatedAt
+	case "rating":
+		return m.AverageRating
+	}
+	return nil
+}
+
+// movieQueryFingerprint hashes everything that shapes a listing except the
+// position in it.
+func movieQueryFingerprint(params dto.MovieListParams, order MovieOrder) string {
+	genre, _ := NormalizeGenre(params.Genre)
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%d\x00%d\x00%d\x00%s\x00%s",
+		params.Search, genre, order,
+		params.MinReleaseYear, params.MaxReleaseYear, params.CreatedAfter.UnixNano(),
+		formatFloatBound(params.MinRating), formatFloatBound(params.MaxRating),
+	)
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+func formatFloatBound(bound *float64) string {
+	if bound == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*bound, 'g', -1, 64)
+}
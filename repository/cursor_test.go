@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+)
+
+func TestMovieCursorRoundTrip(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC)
+	m := &models.Movie{
+		ID:             "b",
+		Title:          "Heat",
+		ReleaseYear:    1995,
+		RuntimeMinutes: 170,
+		CreatedAt:      created,
+		UpdatedAt:      created.Add(time.Hour),
+		AverageRating:  8.5,
+	}
+	params := dto.MovieListParams{Search: "heat", Genre: "Crime"}
+
+	for _, orderBy := range []string{"title", "release_year desc", "runtime_minutes", "created_at desc", "updated_at", "rating desc"} {
+		order, err := ParseMovieOrder(orderBy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := encodeMovieCursor(params, order, m)
+		if err != nil {
+			t.Fatalf("%s: encodeMovieCursor: %v", orderBy, err)
+		}
+		cursor, err := decodeMovieCursor(token, params, order)
+		if err != nil {
+			t.Fatalf("%s: decodeMovieCursor: %v", orderBy, err)
+		}
+		if cursor.ID != m.ID {
+			t.Errorf("%s: cursor id = %q, want %q", orderBy, cursor.ID, m.ID)
+		}
+		if compareMovies(m, cursor, order) != 0 {
+			t.Errorf("%s: cursor %v does not sort with the movie it came from", orderBy, movieSortValue(cursor, order.Key))
+		}
+	}
+}
+
+func TestMovieCursorTies(t *testing.T) {
+	params := dto.MovieListParams{}
+	for _, orderBy := range []string{"release_year", "release_year desc"} {
+		order, _ := ParseMovieOrder(orderBy)
+		token, err := encodeMovieCursor(params, order, &models.Movie{ID: "b", ReleaseYear: 2000})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cursor, err := decodeMovieCursor(token, params, order)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Movies tied on the sort value follow the cursor by id, ascending
+		// in both directions.
+		tests := []struct {
+			movie *models.Movie
+			after bool
+		}{
+			{&models.Movie{ID: "a", ReleaseYear: 2000}, false},
+			{&models.Movie{ID: "b", ReleaseYear: 2000}, false},
+			{&models.Movie{ID: "c", ReleaseYear: 2000}, true},
+			{&models.Movie{ID: "a", ReleaseYear: 1999}, order.Desc},
+			{&models.Movie{ID: "z", ReleaseYear: 2001}, !order.Desc},
+		}
+		for _, tt := range tests {
+			if got := compareMovies(tt.movie, cursor, order) > 0; got != tt.after {
+				t.Errorf("%s: movie %s/%d after cursor = %v, want %v", orderBy, tt.movie.ID, tt.movie.ReleaseYear, got, tt.after)
+			}
+		}
+	}
+}
+
+func TestDecodeMovieCursorRejects(t *testing.T) {
+	order, _ := ParseMovieOrder("title")
+	params := dto.MovieListParams{Search: "heat"}
+	token, err := encodeMovieCursor(params, order, &models.Movie{ID: "a", Title: "Heat"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc, _ := ParseMovieOrder("title desc")
+
+	tests := []struct {
+		name   string
+		token  string
+		params dto.MovieListParams
+		order  MovieOrder
+	}{
+		{"not base64", "!!!", params, order},
+		{"not json", "bm90IGpzb24", params, order},
+		{"other filters", token, dto.MovieListParams{Search: "ronin"}, order},
+		{"other order", token, params, desc},
+	}
+	for _, tt := range tests {
+		if _, err := decodeMovieCursor(tt.token, tt.params, tt.order); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: decodeMovieCursor error = %v, want ErrInvalid", tt.name, err)
+		}
+	}
+}
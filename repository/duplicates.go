@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	"gorm.io/gorm"
+)
+
+// NormalizeTitle folds the case and punctuation out of a title, so "The
+// Matrix", "the matrix!" and "THE  MATRIX" are the same title.
+func NormalizeTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// duplicateMovieError reports that a live movie, id, already has the title
+// and release year a write would give another one.
+func duplicateMovieError(id string) error {
+	return &ResourceError{
+		Kind:     ErrAlreadyExists,
+		Resource: "movie",
+		Name:     id,
+		message:  fmt.Sprintf("movie %s already has this title and release year", id),
+	}
+}
+
+// movieTitleKey returns title as it is stored in normalized_title, or fails
+// with a duplicateMovieError when a live movie other than id already has
+// that title and release year. Writes call it before storing a title or year
+// and store the key with them; the unique index backs the check up.
+func movieTitleKey(tx *gorm.DB, id, title string, year int32) (string, error) {
+	key := NormalizeTitle(title)
+
+	var existing []string
+	err := tx.Model(&models.Movie{}).
+		Where("normalized_title = ? AND release_year = ? AND id <> ?", key, year, id).
+		Limit(1).
+		Pluck("id", &existing).Error
+	if err != nil {
+		return "", err
+	}
+	if len(existing) > 0 {
+		return "", duplicateMovieError(existing[0])
+	}
+	return key, nil
+}
+
+// duplicatePair is two movies whose titles are alike enough to be the same
+// movie entered twice. first is the older one.
+type duplicatePair struct {
+	first, second string
+	similarity    float64
+}
+
+type titleCandidate struct {
+	id    string
+	title []rune
+	year  int32
+}
+
+// findDuplicatePairs compares the normalized titles of movies, given oldest
+// first, and returns at most limit pairs at least minSimilarity alike, most
+// alike first. Movies with different known release years are never paired;
+// an unknown year, 0, matches any. It compares every pair, which is fine for
+// an occasional admin check of a catalog this size.
+func findDuplicatePairs(movies []*models.Movie, minSimilarity float64, limit int) []duplicatePair {
+	candidates := make([]titleCandidate, len(movies))
+	for i, m := range movies {
+		candidates[i] = titleCandidate{
+			id:    m.ID,
+			title: []rune(NormalizeTitle(m.Title)),
+			year:  m.ReleaseYear,
+		}
+	}
+
+	var pairs []duplicatePair
+	for i, a := range candidates {
+		for _, b := range candidates[i+1:] {
+			if a.year != 0 && b.year != 0 && a.year != b.year {
+				continue
+			}
+			similarity := titleSimilarity(a.title, b.title, minSimilarity)
+			if similarity >= minSimilarity {
+				pairs = append(pairs, duplicatePair{first: a.id, second: b.id, similarity: similarity})
+			}
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].similarity > pairs[j].similarity
+	})
+	if len(pairs) > limit {
+		pairs = pairs[:limit]
+	}
+	return pairs
+}
+
+// titleSimilarity is one minus the edit distance between a and b relative to
+// the longer of the two: 1 for equal titles, 0 for nothing in common. Pairs
+// whose lengths alone rule out reaching threshold score 0 without the distance
+// being computed.
+func titleSimilarity(a, b []rune, threshold float64) float64 {
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 1
+	}
+	if 1-float64(abs(len(a)-len(b)))/float64(longest) < threshold {
+		return 0
+	}
+	return 1 - float64(editDistance(a, b))/float64(longest)
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"math"
+	"testing"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		title, want string
+	}{
+		{"The Matrix", "the matrix"},
+		{"the matrix!", "the matrix"},
+		{"  THE  MATRIX ", "the matrix"},
+		{"Spider-Man: No Way Home", "spider man no way home"},
+		{"Amélie", "amélie"},
+		{"2001: A Space Odyssey", "2001 a space odyssey"},
+		{"?!", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeTitle(tt.title); got != tt.want {
+			t.Errorf("NormalizeTitle(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestFindDuplicatePairs(t *testing.T) {
+	movies := []*models.Movie{
+		{ID: "1", Title: "Inception", ReleaseYear: 2010},
+		{ID: "2", Title: "Incepton", ReleaseYear: 2010},
+		{ID: "3", Title: "Inception", ReleaseYear: 2011},
+		{ID: "4", Title: "INCEPTION!", ReleaseYear: 0},
+		{ID: "5", Title: "Heat", ReleaseYear: 1995},
+		{ID: "6", Title: "Heist", ReleaseYear: 1995},
+	}
+
+	pairs := findDuplicatePairs(movies, 0.85, 10)
+	want := []duplicatePair{
+		// An unknown year matches any, and equal titles come first.
+		{first: "1", second: "4", similarity: 1},
+		{first: "3", second: "4", similarity: 1},
+		{first: "1", second: "2", similarity: 1 - 1.0/9},
+		{first: "2", second: "4", similarity: 1 - 1.0/9},
+	}
+	if len(pairs) != len(want) {
+		t.Fatalf("findDuplicatePairs = %+v, want %+v", pairs, want)
+	}
+	for i := range want {
+		if pairs[i].first != want[i].first || pairs[i].second != want[i].second || !almostEqual(pairs[i].similarity, want[i].similarity) {
+			t.Errorf("pair %d = %+v, want %+v", i, pairs[i], want[i])
+		}
+	}
+
+	if pairs := findDuplicatePairs(movies, 0.85, 1); len(pairs) != 1 || pairs[0].second != want[0].second {
+		t.Errorf("findDuplicatePairs with limit 1 = %+v, want %+v", pairs, want[:1])
+	}
+	if pairs := findDuplicatePairs(movies, 0.5, 10); !containsPair(pairs, "5", "6") {
+		t.Errorf("findDuplicatePairs at 0.5 = %+v, want Heat and Heist paired", pairs)
+	}
+}
+
+func containsPair(pairs []duplicatePair, first, second string) bool {
+	for _, p := range pairs {
+		if p.first == first && p.second == second {
+			return true
+		}
+	}
+	return false
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 1},
+		{"heat", "heat", 1},
+		{"heat", "", 0},
+		{"kitten", "sitting", 1 - 3.0/7},
+		{"arrival", "arival", 1 - 1.0/7},
+	}
+	for _, tt := range tests {
+		if got := titleSimilarity([]rune(tt.a), []rune(tt.b), 0); !almostEqual(got, tt.want) {
+			t.Errorf("titleSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	// Lengths alone rule out the threshold, so the distance is skipped.
+	if got := titleSimilarity([]rune("up"), []rune("the lord of the rings"), 0.5); got != 0 {
+		t.Errorf("titleSimilarity below threshold = %v, want 0", got)
+	}
+}
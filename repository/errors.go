@@ -0,0 +1,67 @@
+package repository
+
+import "errors"
+
+// The kinds of failure callers are expected to tell apart. Every error a
+// repository returns for bad input or for the state of a resource wraps one
+// of them; anything else is an internal failure.
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrConflict means the call lost a race with a concurrent write to the
+	// same data. Retrying it may succeed, or fail with a more specific error.
+	ErrConflict = errors.New("conflict")
+	// ErrFailedPrecondition means the resource is not in the state the call
+	// requires, such as a movie at another version than the caller expected.
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrInvalid            = errors.New("invalid")
+)
+
+// ResourceError is a failure concerning one resource as a whole, such as a
+// movie that does not exist. It wraps its Kind.
+type ResourceError struct {
+	Kind     error
+	Resource string
+	// Name identifies the resource when it is not the one the caller asked
+	// about, such as the existing movie a new one would duplicate.
+	Name    string
+	message string
+}
+
+func newResourceError(kind error, resource, message string) error {
+	return &ResourceError{
+		Kind:     kind,
+		Resource: resource,
+		message:  message,
+	}
+}
+
+func (e *ResourceError) Error() string {
+	return e.message
+}
+
+func (e *ResourceError) Unwrap() error {
+	return e.Kind
+}
+
+// FieldError is an ErrInvalid naming the input field at fault, in the
+// notation of the request, such as "order_by" or "review.score".
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func newFieldError(field, description string) error {
+	return &FieldError{
+		Field:       field,
+		Description: description,
+	}
+}
+
+func (e *FieldError) Error() string {
+	return e.Description
+}
+
+func (e *FieldError) Unwrap() error {
+	return ErrInvalid
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+)
+
+// genreAliases maps the space-separated words of common spellings onto the
+// slug of the genre they mean.
+var genreAliases = map[string]string{
+	"sci fi":          "science-fiction",
+	"scifi":           "science-fiction",
+	"sf":              "science-fiction",
+	"science fiction": "science-fiction",
+	"rom com":         "romantic-comedy",
+	"romcom":          "romantic-comedy",
+	"romantic comedy": "romantic-comedy",
+	"doc":             "documentary",
+	"docu":            "documentary",
+	"animated":        "animation",
+	"anime":           "animation",
+}
+
+var genreNames = map[string]string{
+	"science-fiction": "Science Fiction",
+	"romantic-comedy": "Romantic Comedy",
+}
+
+// NormalizeGenre returns the slug identifying a free-text genre and the name
+// it is displayed under. Case, punctuation and known aliases are folded, so
+// "Sci-Fi", "sci fi" and "Science Fiction" all yield "science-fiction". An
+// empty slug means name holds no letters or digits.
+func NormalizeGenre(name string) (slug, display string) {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "", ""
+	}
+
+	slug = strings.Join(words, "-")
+	if canonical, ok := genreAliases[strings.Join(words, " ")]; ok {
+		slug = canonical
+	}
+	if display, ok := genreNames[slug]; ok {
+		return slug, display
+	}
+
+	parts := strings.Split(slug, "-")
+	for i, w := range parts {
+		runes := []rune(w)
+		runes[0] = unicode.ToUpper(runes[0])
+		parts[i] = string(runes)
+	}
+	return slug, strings.Join(parts, " ")
+}
+
+// SplitGenres breaks the legacy single-string genre into its parts.
+func SplitGenres(genre string) []string {
+	return strings.FieldsFunc(genre, func(r rune) bool {
+		return r == ',' || r == '/' || r == '|' || r == ';'
+	})
+}
+
+// movieGenreNames returns the genres a write asks for: genres when set,
+// otherwise the legacy genre string split into its parts.
+func movieGenreNames(genres []string, legacy string) []string {
+	if len(genres) > 0 {
+		return genres
+	}
+	return SplitGenres(legacy)
+}
+
+func genreNamesOf(genres []models.Genre) []string {
+	names := make([]string, len(genres))
+	for i, g := range genres {
+		names[i] = g.Name
+	}
+	sort.Strings(names)
+	return names
+}
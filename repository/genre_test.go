@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeGenre(t *testing.T) {
+	tests := []struct {
+		name, slug, display string
+	}{
+		{"Drama", "drama", "Drama"},
+		{"  film NOIR!! ", "film-noir", "Film Noir"},
+		{"Sci-Fi", "science-fiction", "Science Fiction"},
+		{"sci fi", "science-fiction", "Science Fiction"},
+		{"Science Fiction", "science-fiction", "Science Fiction"},
+		{"RomCom", "romantic-comedy", "Romantic Comedy"},
+		{"anime", "animation", "Animation"},
+		{"Ciné-Vérité", "ciné-vérité", "Ciné Vérité"},
+		{"80s", "80s", "80s"},
+		{"", "", ""},
+		{" -/- ", "", ""},
+	}
+	for _, tt := range tests {
+		slug, display := NormalizeGenre(tt.name)
+		if slug != tt.slug || display != tt.display {
+			t.Errorf("NormalizeGenre(%q) = %q, %q, want %q, %q", tt.name, slug, display, tt.slug, tt.display)
+		}
+	}
+}
+
+func TestSplitGenres(t *testing.T) {
+	tests := []struct {
+		genre string
+		want  []string
+	}{
+		{"", nil},
+		{"Drama", []string{"Drama"}},
+		{"Action, Sci-Fi/Thriller|Crime;Drama", []string{"Action", " Sci-Fi", "Thriller", "Crime", "Drama"}},
+		{",,", nil},
+	}
+	for _, tt := range tests {
+		if got := SplitGenres(tt.genre); !slices.Equal(got, tt.want) {
+			t.Errorf("SplitGenres(%q) = %q, want %q", tt.genre, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrGenreNotFound      = newResourceError(ErrNotFound, "genre", "genre not found")
+	ErrGenreAlreadyExists = newResourceError(ErrAlreadyExists, "genre", "genre already exists")
+)
+
+type GenreRepository interface {
+	CreateGenre(ctx context.Context, genre *pb.Genre) error
+	GetGenre(ctx context.Context, id string) (*pb.Genre, error)
+	GetGenres(ctx context.Context) ([]*pb.Genre, error)
+	UpdateGenre(ctx context.Context, genre *pb.Genre) (*pb.Genre, error)
+	DeleteGenre(ctx context.Context, id string) error
+	GetGenreMovieIDs(ctx context.Context, id string) ([]string, error)
+}
+
+type genreRepository struct {
+	db *gorm.DB
+	// search is set when the movies_fts index is usable; genre names are
+	// indexed with the movies that carry them.
+	search bool
+}
+
+func NewGenreRepository(db *gorm.DB) GenreRepository {
+	return &genreRepository{
+		db:     db,
+		search: hasMovieSearch(db),
+	}
+}
+
+func (r *genreRepository) CreateGenre(ctx context.Context, genre *pb.Genre) error {
+	data, err := genreFromProto(genre)
+	if err != nil {
+		return err
+	}
+	data.ID = uuid.New().String()
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.Genre{}).Where("slug = ?", data.Slug).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrGenreAlreadyExists
+	}
+
+	res := r.db.WithContext(ctx).Create(data)
+	if res.Error != nil {
+		return conflictError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("genre creation unsuccessful")
+	}
+
+	genre.Id = data.ID
+	genre.Name = data.Name
+	genre.Slug = data.Slug
+	return nil
+}
+
+// GetGenre looks a genre up by id, or by anything that normalizes to its slug.
+func (r *genreRepository) GetGenre(ctx context.Context, id string) (*pb.Genre, error) {
+	var genre models.Genre
+	slug, _ := NormalizeGenre(id)
+	res := r.db.WithContext(ctx).Where("id = ? OR slug = ?", id, slug).Limit(1).Find(&genre)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, ErrGenreNotFound
+	}
+	return genreToProto(&genre), nil
+}
+
+func (r *genreRepository) GetGenres(ctx context.Context) ([]*pb.Genre, error) {
+	var genres []*models.Genre
+	if err := r.db.WithContext(ctx).Order("name").Find(&genres).Error; err != nil {
+		return nil, err
+	}
+
+	pbGenres := make([]*pb.Genre, len(genres))
+	for i, g := range genres {
+		pbGenres[i] = genreToProto(g)
+	}
+	return pbGenres, nil
+}
+
+func (r *genreRepository) UpdateGenre(ctx context.Context, genre *pb.Genre) (*pb.Genre, error) {
+	data, err := genreFromProto(genre)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.Genre{}).Where("slug = ? AND id <> ?", data.Slug, genre.GetId()).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrGenreAlreadyExists
+	}
+
+	err = transaction(ctx, r.db, func(tx *gorm.DB) error {
+		res := tx.Model(&models.Genre{}).Where("id = ?", genre.GetId()).Updates(models.Genre{Name: data.Name, Slug: data.Slug})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrGenreNotFound
+		}
+		movieIDs := tx.Table("movie_genres").Select("movie_id").Where("genre_id = ?", genre.GetId())
+		if err := r.syncSearch(tx, movieIDs); err != nil {
+			return err
+		}
+		// Every movie in the genre now lists it under the new name.
+		return recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, movieIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetGenre(ctx, genre.GetId())
+}
+
+func (r *genreRepository) DeleteGenre(ctx context.Context, id string) error {
+	return transaction(ctx, r.db, func(tx *gorm.DB) error {
+		res := tx.Where("id = ?", id).Delete(&models.Genre{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrGenreNotFound
+		}
+
+		var movieIDs []string
+		if err := tx.Table("movie_genres").Where("genre_id = ?", id).Pluck("movie_id", &movieIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM movie_genres WHERE genre_id = ?", id).Error; err != nil {
+			return err
+		}
+		if len(movieIDs) == 0 {
+			return nil
+		}
+		if err := r.syncSearch(tx, movieIDs); err != nil {
+			return err
+		}
+		return recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, movieIDs)
+	})
+}
+
+func (r *genreRepository) syncSearch(tx *gorm.DB, ids interface{}) error {
+	if !r.search {
+		return nil
+	}
+	return syncMovieSearch(tx, ids)
+}
+
+func (r *genreRepository) GetGenreMovieIDs(ctx context.Context, id string) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Table("movie_genres").Where("genre_id = ?", id).Pluck("movie_id", &ids).Error
+	return ids, err
+}
+
+func genreToProto(g *models.Genre) *pb.Genre {
+	return &pb.Genre{
+		Id:   g.ID,
+		Name: g.Name,
+		Slug: g.Slug,
+	}
+}
+
+// genreFromProto keeps the name as given so curated genres can be displayed
+// however the caller likes; only the slug is normalized.
+func genreFromProto(genre *pb.Genre) (*models.Genre, error) {
+	name := strings.TrimSpace(genre.GetName())
+	slug, _ := NormalizeGenre(name)
+	if slug == "" {
+		return nil, newFieldError("genre.name", "genre name is required")
+	}
+	return &models.Genre{
+		ID:   genre.GetId(),
+		Name: name,
+		Slug: slug,
+	}, nil
+}
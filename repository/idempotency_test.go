@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestCreateMovieIdempotent(t *testing.T) {
+	ctx := context.Background()
+	since := Now().Add(-time.Hour)
+
+	for name, repo := range movieRepositories(t) {
+		key := dto.IdempotencyKey{Key: "k1", RequestHash: "heat", Since: since}
+		first, replayed, err := repo.CreateMovieIdempotent(ctx, &pb.Movie{Title: "Heat"}, key)
+		if err != nil || replayed {
+			t.Fatalf("%s: first call = %v, replayed %v, %v", name, first, replayed, err)
+		}
+
+		again, replayed, err := repo.CreateMovieIdempotent(ctx, &pb.Movie{Title: "Heat"}, key)
+		if err != nil || !replayed || again.GetId() != first.GetId() {
+			t.Errorf("%s: retry = %v, replayed %v, %v, want %s replayed", name, again, replayed, err, first.GetId())
+		}
+
+		reused := dto.IdempotencyKey{Key: "k1", RequestHash: "ronin", Since: since}
+		if _, _, err := repo.CreateMovieIdempotent(ctx, &pb.Movie{Title: "Ronin"}, reused); !errors.Is(err, ErrIdempotencyKeyReused) {
+			t.Errorf("%s: reused key error = %v, want ErrIdempotencyKeyReused", name, err)
+		}
+
+		// Keys older than the window are forgotten.
+		expired := dto.IdempotencyKey{Key: "k1", RequestHash: "ronin", Since: Now().Add(time.Hour)}
+		if _, replayed, err := repo.CreateMovieIdempotent(ctx, &pb.Movie{Title: "Ronin"}, expired); err != nil || replayed {
+			t.Errorf("%s: expired key = replayed %v, %v, want a new movie", name, replayed, err)
+		}
+
+		result, err := repo.GetMovies(ctx, dto.MovieListParams{IncludeTotal: true})
+		if err != nil || result.TotalRecords != 2 {
+			t.Errorf("%s: %d movies, %v, want 2", name, result.TotalRecords, err)
+		}
+	}
+}
+
+func TestCreateMovieIdempotentConcurrent(t *testing.T) {
+	ctx := context.Background()
+	const callers = 8
+
+	// Every SQLite caller gets its own handle on one database, the way
+	// replicas of the server would.
+	memoryRepo := NewMemoryMovieRepository(NewMemoryDB())
+	dsn := openTestDB(t).Dialector.(*sqlite.Dialector).DSN
+	backends := map[string]func() MovieRepository{
+		"memory": func() MovieRepository { return memoryRepo },
+		"sqlite": func() MovieRepository {
+			db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{NowFunc: Now, Logger: logger.Default.LogMode(logger.Silent)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				if sqlDB, err := db.DB(); err == nil {
+					sqlDB.Close()
+				}
+			})
+			return NewMovieRepository(db)
+		},
+	}
+
+	for name, newRepo := range backends {
+		repos := make([]MovieRepository, callers)
+		for i := range repos {
+			repos[i] = newRepo()
+		}
+		type outcome struct {
+			movie    *pb.Movie
+			replayed bool
+			err      error
+		}
+		outcomes := make([]outcome, callers)
+		var wg sync.WaitGroup
+		for i := range outcomes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				key := dto.IdempotencyKey{Key: "shared", RequestHash: "heat", Since: Now().Add(-time.Hour)}
+				movie, replayed, err := repos[i].CreateMovieIdempotent(ctx, &pb.Movie{Title: "Heat", ReleaseYear: 1995}, key)
+				outcomes[i] = outcome{movie, replayed, err}
+			}()
+		}
+		wg.Wait()
+
+		created := 0
+		for i, o := range outcomes {
+			if o.err != nil {
+				t.Errorf("%s: call %d failed: %v", name, i, o.err)
+				continue
+			}
+			if !o.replayed {
+				created++
+			}
+			if o.movie.GetId() != outcomes[0].movie.GetId() {
+				t.Errorf("%s: call %d got movie %s, call 0 got %s", name, i, o.movie.GetId(), outcomes[0].movie.GetId())
+			}
+		}
+		if created != 1 {
+			t.Errorf("%s: %d calls created a movie, want 1", name, created)
+		}
+	}
+}
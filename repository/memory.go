@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+)
+
+// MemoryDB is the process-local store behind the in-memory repositories. It
+// plays the role *gorm.DB plays for the SQLite ones: repositories built on the
+// same MemoryDB see each other's writes.
+type MemoryDB struct {
+	mu          sync.RWMutex
+	movies      map[string]*models.Movie
+	order       []string
+	genres      map[string]*models.Genre
+	movieGenres map[string][]string
+	people      map[string]*models.Person
+	credits     map[string]*models.Credit
+	reviews     map[string]*models.Review
+	events      []*models.MovieEvent
+	// deleted holds the movies DeleteMovie marked deleted. They keep their
+	// genres, credits and reviews until they are purged.
+	deleted map[string]*models.Movie
+	// idempotencyKeys holds the keys CreateMovieIdempotent has seen.
+	idempotencyKeys map[string]*models.IdempotencyKey
+}
+
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		movies:          make(map[string]*models.Movie),
+		deleted:         make(map[string]*models.Movie),
+		idempotencyKeys: make(map[string]*models.IdempotencyKey),
+		genres:          make(map[string]*models.Genre),
+		movieGenres:     make(map[string][]string),
+		people:          make(map[string]*models.Person),
+		credits:         make(map[string]*models.Credit),
+		reviews:         make(map[string]*models.Review),
+	}
+}
+
+// movie returns a copy of the stored movie with its genres attached, the way
+// a Preload would.
+func (db *MemoryDB) movie(id string) *models.Movie {
+	return db.withGenres(db.movies[id])
+}
+
+// withGenres returns a copy of stored with its genres attached.
+func (db *MemoryDB) withGenres(stored *models.Movie) *models.Movie {
+	m := *stored
+	m.Genres = nil
+	for _, genreID := range db.movieGenres[m.ID] {
+		if g, ok := db.genres[genreID]; ok {
+			m.Genres = append(m.Genres, *g)
+		}
+	}
+	return &m
+}
+
+func (db *MemoryDB) genreBySlug(slug string) *models.Genre {
+	for _, g := range db.genres {
+		if g.Slug == slug {
+			return g
+		}
+	}
+	return nil
+}
+
+func (db *MemoryDB) hasGenre(movieID, slug string) bool {
+	for _, genreID := range db.movieGenres[movieID] {
+		if g, ok := db.genres[genreID]; ok && g.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteCredits removes every credit match reports true for.
+func (db *MemoryDB) deleteCredits(match func(*models.Credit) bool) {
+	for id, c := range db.credits {
+		if match(c) {
+			delete(db.credits, id)
+		}
+	}
+}
+
+// recordMovieEvent is the in-memory counterpart of recordMovieEvents. The
+// caller holds the write lock.
+func (db *MemoryDB) recordMovieEvent(eventType pb.MovieEventType, movieID string) {
+	var revision int64 = 1
+	if n := len(db.events); n > 0 {
+		revision = db.events[n-1].Revision + 1
+	}
+	db.events = append(db.events, &models.MovieEvent{
+		Revision:  revision,
+		MovieID:   movieID,
+		Type:      eventType.String(),
+		CreatedAt: Now(),
+	})
+}
+
+// refreshMovieRating is the in-memory counterpart of refreshMovieRating. The
+// caller holds the write lock.
+func (db *MemoryDB) refreshMovieRating(movieID string) {
+	m, live := db.movies[movieID]
+	if !live {
+		if m = db.deleted[movieID]; m == nil {
+			return
+		}
+	}
+	var total, count int64
+	for _, rv := range db.reviews {
+		if rv.MovieID == movieID {
+			total += int64(rv.Score)
+			count++
+		}
+	}
+	m.ReviewCount = count
+	m.AverageRating = 0
+	if count > 0 {
+		m.AverageRating = float64(total) / float64(count)
+	}
+	if live {
+		db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, movieID)
+	}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+)
+
+type memoryCreditRepository struct {
+	db *MemoryDB
+}
+
+func NewMemoryCreditRepository(db *MemoryDB) CreditRepository {
+	return &memoryCreditRepository{
+		db: db,
+	}
+}
+
+func (r *memoryCreditRepository) CreateCredit(ctx context.Context, credit *pb.Credit) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.movies[credit.GetMovieId()]; !ok {
+		return ErrMovieNotFound
+	}
+	person, ok := r.db.people[credit.GetPersonId()]
+	if !ok {
+		return ErrPersonNotFound
+	}
+
+	credit.Id = uuid.New().String()
+	data := creditFromProto(credit)
+	data.CreatedAt = Now()
+	data.UpdatedAt = data.CreatedAt
+	r.db.credits[data.ID] = data
+
+	credit.PersonName = person.Name
+	return nil
+}
+
+func (r *memoryCreditRepository) DeleteCredit(ctx context.Context, id string) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.credits[id]; !ok {
+		return ErrCreditNotFound
+	}
+	delete(r.db.credits, id)
+	return nil
+}
+
+func (r *memoryCreditRepository) GetMovieCredits(ctx context.Context, movieID string) ([]*pb.Credit, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	var credits []*models.Credit
+	for _, c := range r.db.credits {
+		if c.MovieID == movieID {
+			credits = append(credits, r.credit(c))
+		}
+	}
+	sort.Slice(credits, func(i, j int) bool {
+		if credits[i].BillingOrder != credits[j].BillingOrder {
+			return credits[i].BillingOrder < credits[j].BillingOrder
+		}
+		return credits[i].ID < credits[j].ID
+	})
+
+	pbCredits := make([]*pb.Credit, len(credits))
+	for i, c := range credits {
+		pbCredits[i] = creditToProto(c)
+	}
+	return pbCredits, nil
+}
+
+func (r *memoryCreditRepository) GetPersonMovies(ctx context.Context, personID string, role pb.CreditRole) ([]*pb.PersonMovie, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if _, ok := r.db.people[personID]; !ok {
+		return nil, ErrPersonNotFound
+	}
+
+	var credits []*models.Credit
+	for _, c := range r.db.credits {
+		if c.PersonID != personID {
+			continue
+		}
+		if _, ok := r.db.movies[c.MovieID]; !ok {
+			continue
+		}
+		if role != pb.CreditRole_CREDIT_ROLE_UNSPECIFIED && c.Role != role.String() {
+			continue
+		}
+		credits = append(credits, r.credit(c))
+	}
+	sort.Slice(credits, func(i, j int) bool {
+		a, b := credits[i].Movie, credits[j].Movie
+		if a.ReleaseYear != b.ReleaseYear {
+			return a.ReleaseYear > b.ReleaseYear
+		}
+		return a.Title < b.Title
+	})
+
+	movies := make([]*pb.PersonMovie, len(credits))
+	for i, c := range credits {
+		movies[i] = &pb.PersonMovie{
+			Movie:  movieToProto(&c.Movie),
+			Credit: creditToProto(c),
+		}
+	}
+	return movies, nil
+}
+
+// credit returns a copy of c with its movie and person attached. The caller
+// holds the lock.
+func (r *memoryCreditRepository) credit(c *models.Credit) *models.Credit {
+	credit := *c
+	if _, ok := r.db.movies[c.MovieID]; ok {
+		credit.Movie = *r.db.movie(c.MovieID)
+	}
+	if p, ok := r.db.people[c.PersonID]; ok {
+		credit.Person = *p
+	}
+	return &credit
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+)
+
+type memoryGenreRepository struct {
+	db *MemoryDB
+}
+
+func NewMemoryGenreRepository(db *MemoryDB) GenreRepository {
+	return &memoryGenreRepository{
+		db: db,
+	}
+}
+
+func (r *memoryGenreRepository) CreateGenre(ctx context.Context, genre *pb.Genre) error {
+	data, err := genreFromProto(genre)
+	if err != nil {
+		return err
+	}
+
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if r.db.genreBySlug(data.Slug) != nil {
+		return ErrGenreAlreadyExists
+	}
+	data.ID = uuid.New().String()
+	data.CreatedAt = Now()
+	data.UpdatedAt = data.CreatedAt
+	r.db.genres[data.ID] = data
+
+	genre.Id = data.ID
+	genre.Name = data.Name
+	genre.Slug = data.Slug
+	return nil
+}
+
+func (r *memoryGenreRepository) GetGenre(ctx context.Context, id string) (*pb.Genre, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if g, ok := r.db.genres[id]; ok {
+		return genreToProto(g), nil
+	}
+	if slug, _ := NormalizeGenre(id); slug != "" {
+		if g := r.db.genreBySlug(slug); g != nil {
+			return genreToProto(g), nil
+		}
+	}
+	return nil, ErrGenreNotFound
+}
+
+func (r *memoryGenreRepository) GetGenres(ctx context.Context) ([]*pb.Genre, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	genres := make([]*pb.Genre, 0, len(r.db.genres))
+	for _, g := range r.db.genres {
+		genres = append(genres, genreToProto(g))
+	}
+	sort.Slice(genres, func(i, j int) bool { return genres[i].Name < genres[j].Name })
+	return genres, nil
+}
+
+func (r *memoryGenreRepository) UpdateGenre(ctx context.Context, genre *pb.Genre) (*pb.Genre, error) {
+	data, err := genreFromProto(genre)
+	if err != nil {
+		return nil, err
+	}
+
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	existing, ok := r.db.genres[genre.GetId()]
+	if !ok {
+		return nil, ErrGenreNotFound
+	}
+	if other := r.db.genreBySlug(data.Slug); other != nil && other.ID != existing.ID {
+		return nil, ErrGenreAlreadyExists
+	}
+	existing.Name = data.Name
+	existing.Slug = data.Slug
+	existing.UpdatedAt = Now()
+	for _, movieID := range r.db.order {
+		if r.db.hasGenre(movieID, existing.Slug) {
+			r.db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, movieID)
+		}
+	}
+	return genreToProto(existing), nil
+}
+
+func (r *memoryGenreRepository) DeleteGenre(ctx context.Context, id string) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.genres[id]; !ok {
+		return ErrGenreNotFound
+	}
+	delete(r.db.genres, id)
+	for _, movieID := range r.db.order {
+		genreIDs := r.db.movieGenres[movieID]
+		for i, genreID := range genreIDs {
+			if genreID == id {
+				r.db.movieGenres[movieID] = append(genreIDs[:i], genreIDs[i+1:]...)
+				r.db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, movieID)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (r *memoryGenreRepository) GetGenreMovieIDs(ctx context.Context, id string) ([]string, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	var ids []string
+	for _, movieID := range r.db.order {
+		for _, genreID := range r.db.movieGenres[movieID] {
+			if genreID == id {
+				ids = append(ids, movieID)
+				break
+			}
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+)
+
+type memoryMovieEventRepository struct {
+	db *MemoryDB
+}
+
+func NewMemoryMovieEventRepository(db *MemoryDB) MovieEventRepository {
+	return &memoryMovieEventRepository{
+		db: db,
+	}
+}
+
+func (r *memoryMovieEventRepository) GetMovieEvents(ctx context.Context, after int64, limit int) ([]*pb.MovieEvent, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	start := sort.Search(len(r.db.events), func(i int) bool {
+		return r.db.events[i].Revision > after
+	})
+	end := min(start+limit, len(r.db.events))
+
+	events := make([]*pb.MovieEvent, 0, end-start)
+	for _, e := range r.db.events[start:end] {
+		events = append(events, movieEventToProto(e))
+	}
+	return events, nil
+}
+
+func (r *memoryMovieEventRepository) LatestMovieRevision(ctx context.Context) (int64, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if n := len(r.db.events); n > 0 {
+		return r.db.events[n-1].Revision, nil
+	}
+	return 0, nil
+}
+
+func (r *memoryMovieEventRepository) PruneMovieEvents(ctx context.Context, before time.Time) (int64, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	// Events are in revision order, which is also the order they were
+	// recorded in.
+	n := sort.Search(len(r.db.events), func(i int) bool {
+		return !r.db.events[i].CreatedAt.Before(before)
+	})
+	n = min(n, len(r.db.events)-1)
+	if n <= 0 {
+		return 0, nil
+	}
+	r.db.events = append([]*models.MovieEvent(nil), r.db.events[n:]...)
+	return int64(n), nil
+}
@@ -0,0 +1,638 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type memoryMovieRepository struct {
+	db *MemoryDB
+}
+
+// NewMemoryMovieRepository returns a MovieRepository that keeps everything in
+// process memory. It mirrors the SQLite repository, including ordering, keyset
+// paging, case-insensitive search and its error values, and is meant for tests
+// and running the server without Docker.
+func NewMemoryMovieRepository(db *MemoryDB) MovieRepository {
+	return &memoryMovieRepository{
+		db: db,
+	}
+}
+
+func (r *memoryMovieRepository) CreateMovie(ctx context.Context, movie *pb.Movie) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	movie.Id = uuid.New().String()
+	return r.createMovie(movie)
+}
+
+func (r *memoryMovieRepository) CreateMovieIdempotent(ctx context.Context, movie *pb.Movie, key dto.IdempotencyKey) (*pb.Movie, bool, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	for k, record := range r.db.idempotencyKeys {
+		if record.CreatedAt.Before(key.Since) {
+			delete(r.db.idempotencyKeys, k)
+		}
+	}
+
+	if record, ok := r.db.idempotencyKeys[key.Key]; ok {
+		if record.RequestHash != key.RequestHash {
+			return nil, false, ErrIdempotencyKeyReused
+		}
+		created := &pb.Movie{}
+		if err := proto.Unmarshal(record.Response, created); err != nil {
+			return nil, false, err
+		}
+		return created, true, nil
+	}
+
+	movie.Id = uuid.New().String()
+	if err := r.createMovie(movie); err != nil {
+		return nil, false, err
+	}
+	response, err := proto.Marshal(movie)
+	if err != nil {
+		return nil, false, err
+	}
+	r.db.idempotencyKeys[key.Key] = &models.IdempotencyKey{
+		Key:         key.Key,
+		RequestHash: key.RequestHash,
+		Response:    response,
+		CreatedAt:   Now(),
+	}
+	return movie, false, nil
+}
+
+// createMovie is CreateMovie without the lock and ID assignment. The caller
+// holds the write lock.
+func (r *memoryMovieRepository) createMovie(movie *pb.Movie) error {
+	if id := r.movieWithTitle(movie.GetTitle(), movie.GetReleaseYear(), ""); id != "" {
+		return duplicateMovieError(id)
+	}
+
+	data := movieFromProto(movie)
+	data.Version = 1
+	data.CreatedAt = Now()
+	data.UpdatedAt = data.CreatedAt
+
+	r.db.movies[movie.Id] = data
+	r.db.order = append(r.db.order, movie.Id)
+	r.setGenres(movie.Id, movieGenreNames(movie.GetGenres(), movie.GetGenre()))
+
+	stored := movieToProto(r.db.movie(movie.Id))
+	movie.Genres = stored.Genres
+	movie.Genre = stored.Genre
+	movie.Version = data.Version
+	movie.CreatedAt = timestamppb.New(data.CreatedAt)
+	movie.UpdatedAt = timestamppb.New(data.UpdatedAt)
+	r.db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_CREATED, movie.Id)
+	return nil
+}
+
+// movieWithTitle returns the ID of a live movie other than exclude with the
+// same normalized title and release year, or "". The caller holds the lock.
+func (r *memoryMovieRepository) movieWithTitle(title string, year int32, exclude string) string {
+	title = NormalizeTitle(title)
+	for _, id := range r.db.order {
+		m := r.db.movies[id]
+		if id != exclude && m.ReleaseYear == year && NormalizeTitle(m.Title) == title {
+			return id
+		}
+	}
+	return ""
+}
+
+func (r *memoryMovieRepository) GetMovie(ctx context.Context, id string) (*pb.Movie, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if _, ok := r.db.movies[id]; !ok {
+		return nil, ErrMovieNotFound
+	}
+	return movieToProto(r.db.movie(id)), nil
+}
+
+func (r *memoryMovieRepository) GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	result := make(map[string]*pb.Movie, len(ids))
+	for _, id := range ids {
+		if _, ok := r.db.movies[id]; ok {
+			result[id] = movieToProto(r.db.movie(id))
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryMovieRepository) GetMovies(ctx context.Context, params dto.MovieListParams) (*dto.MovieListResult, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	page, pageSize := params.Page, params.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	order, err := ParseMovieOrder(params.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	search := strings.ToLower(params.Search)
+	genreSlug, _ := NormalizeGenre(params.Genre)
+
+	var matched []*models.Movie
+	for _, id := range r.db.order {
+		m := r.db.movie(id)
+		if search != "" && !strings.Contains(strings.ToLower(m.Title), search) && !genreNameContains(m.Genres, search) {
+			continue
+		}
+		if genreSlug != "" && !r.db.hasGenre(id, genreSlug) {
+			continue
+		}
+		if !matchesMovieFilters(m, params) {
+			continue
+		}
+		matched = append(matched, m)
+	}
+	sortMovies(matched, order)
+
+	var totalRecords int64
+	if params.IncludeTotal {
+		totalRecords = int64(len(matched))
+	}
+
+	offset := (page - 1) * pageSize
+	if params.PageToken != "" {
+		cursor, err := decodeMovieCursor(params.PageToken, params, order)
+		if err != nil {
+			return nil, err
+		}
+		offset = sort.Search(len(matched), func(i int) bool {
+			return compareMovies(matched[i], cursor, order) > 0
+		})
+	}
+	offset = min(offset, len(matched))
+	end := min(offset+pageSize, len(matched))
+
+	result := &dto.MovieListResult{
+		Movies:       make([]*pb.Movie, 0, end-offset),
+		TotalRecords: totalRecords,
+	}
+	for _, m := range matched[offset:end] {
+		result.Movies = append(result.Movies, movieToProto(m))
+	}
+	if end < len(matched) {
+		result.NextPageToken, err = encodeMovieCursor(params, order, matched[end-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryMovieRepository) StreamMovies(ctx context.Context, batchSize int, fn func([]*pb.Movie) error) error {
+	if batchSize < 1 {
+		batchSize = 100
+	}
+
+	// Like FindInBatches, walk in id order and resume after the last id seen,
+	// holding the lock only while a batch is collected.
+	var lastID string
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		r.db.mu.RLock()
+		ids := make([]string, 0, len(r.db.movies))
+		for id := range r.db.movies {
+			if id > lastID {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		if len(ids) > batchSize {
+			ids = ids[:batchSize]
+		}
+		batch := make([]*pb.Movie, len(ids))
+		for i, id := range ids {
+			batch[i] = movieToProto(r.db.movie(id))
+		}
+		r.db.mu.RUnlock()
+
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := fn(batch); err != nil {
+			return err
+		}
+		if len(batch) < batchSize {
+			return nil
+		}
+		lastID = ids[len(ids)-1]
+	}
+}
+
+func (r *memoryMovieRepository) ImportMovies(ctx context.Context, movies []*pb.Movie) ([]dto.MovieImportResult, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	results := make([]dto.MovieImportResult, len(movies))
+	for i, movie := range movies {
+		_, live := r.db.movies[movie.GetId()]
+		_, deleted := r.db.deleted[movie.GetId()]
+		if live || deleted {
+			results[i].Err = r.updateMovie(movie, MovieUpdateMask{}, 0)
+			continue
+		}
+		if movie.GetId() == "" {
+			movie.Id = uuid.New().String()
+		}
+		results[i].Err = r.createMovie(movie)
+		results[i].Created = results[i].Err == nil
+	}
+	return results, nil
+}
+
+func (r *memoryMovieRepository) FindDuplicateMovies(ctx context.Context, minSimilarity float64, limit int) ([]dto.MovieDuplicate, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	movies := make([]*models.Movie, len(r.db.order))
+	for i, id := range r.db.order {
+		movies[i] = r.db.movies[id]
+	}
+
+	pairs := findDuplicatePairs(movies, minSimilarity, limit)
+	duplicates := make([]dto.MovieDuplicate, len(pairs))
+	for i, p := range pairs {
+		duplicates[i] = dto.MovieDuplicate{
+			Movie:      movieToProto(r.db.movie(p.first)),
+			Duplicate:  movieToProto(r.db.movie(p.second)),
+			Similarity: p.similarity,
+		}
+	}
+	return duplicates, nil
+}
+
+func (r *memoryMovieRepository) SearchMovies(ctx context.Context, query string, page, pageSize int) ([]*pb.MovieSearchResult, int64, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, 0, ErrInvalidSearchQuery
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	r.db.mu.RLock()
+	movies := make([]*models.Movie, 0, len(r.db.order))
+	for _, id := range r.db.order {
+		movies = append(movies, r.db.movie(id))
+	}
+	r.db.mu.RUnlock()
+
+	results := rankMovies(movies, terms)
+	offset := min((page-1)*pageSize, len(results))
+	end := min(offset+pageSize, len(results))
+	return results[offset:end], int64(len(results)), nil
+}
+
+func (r *memoryMovieRepository) UpdateMovie(ctx context.Context, movie *pb.Movie, mask MovieUpdateMask, expectedVersion int64) (*pb.Movie, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if err := r.updateMovie(movie, mask, expectedVersion); err != nil {
+		return nil, err
+	}
+	return movieToProto(r.db.movie(movie.GetId())), nil
+}
+
+// updateMovie is UpdateMovie without the lock. The caller holds the write
+// lock.
+func (r *memoryMovieRepository) updateMovie(movie *pb.Movie, mask MovieUpdateMask, expectedVersion int64) error {
+	m, err := r.writableMovie(movie.GetId(), expectedVersion)
+	if err != nil {
+		return err
+	}
+
+	data := movieFromProto(movie)
+	names := movieGenreNames(movie.GetGenres(), movie.GetGenre())
+	if mask.IsZero() {
+		mask = nonZeroMovieUpdateMask(data, names)
+	}
+	if err := checkRequiredMovieColumns(data, mask); err != nil {
+		return err
+	}
+	// The columns are applied to a copy first, so a duplicate title leaves
+	// the stored movie as it was.
+	next := *m
+	for _, column := range mask.Columns {
+		copyMovieColumn(&next, data, column)
+	}
+	if id := r.movieWithTitle(next.Title, next.ReleaseYear, m.ID); id != "" {
+		return duplicateMovieError(id)
+	}
+	*m = next
+	m.UpdatedAt = Now()
+	m.Version++
+	if mask.Genres {
+		r.setGenres(m.ID, names)
+	}
+	r.db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, m.ID)
+	return nil
+}
+
+func (r *memoryMovieRepository) DeleteMovie(ctx context.Context, id string, expectedVersion int64) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	return r.deleteMovie(id, expectedVersion)
+}
+
+func (r *memoryMovieRepository) CreateMovies(ctx context.Context, movies []*pb.Movie) ([]error, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	errs := make([]error, len(movies))
+	for i, movie := range movies {
+		movie.Id = uuid.New().String()
+		errs[i] = r.createMovie(movie)
+	}
+	return errs, nil
+}
+
+func (r *memoryMovieRepository) DeleteMovies(ctx context.Context, ids []string) ([]error, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		errs[i] = r.deleteMovie(id, 0)
+	}
+	return errs, nil
+}
+
+// deleteMovie is DeleteMovie without the lock. The caller holds the write
+// lock.
+func (r *memoryMovieRepository) deleteMovie(id string, expectedVersion int64) error {
+	m, err := r.writableMovie(id, expectedVersion)
+	if err != nil {
+		return err
+	}
+	r.db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_DELETED, id)
+	m.DeletedAt = gorm.DeletedAt{Time: Now(), Valid: true}
+	r.db.deleted[id] = m
+	delete(r.db.movies, id)
+	for i, existing := range r.db.order {
+		if existing == id {
+			r.db.order = append(r.db.order[:i], r.db.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *memoryMovieRepository) UndeleteMovie(ctx context.Context, id string) (*pb.Movie, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	m, err := r.deletedMovie(id)
+	if err != nil {
+		return nil, err
+	}
+	if existing := r.movieWithTitle(m.Title, m.ReleaseYear, id); existing != "" {
+		return nil, duplicateMovieError(existing)
+	}
+	m.DeletedAt = gorm.DeletedAt{}
+	m.UpdatedAt = Now()
+	m.Version++
+	delete(r.db.deleted, id)
+	r.db.movies[id] = m
+	r.db.order = append(r.db.order, id)
+	r.db.recordMovieEvent(pb.MovieEventType_MOVIE_EVENT_TYPE_CREATED, id)
+	return movieToProto(r.db.movie(id)), nil
+}
+
+func (r *memoryMovieRepository) ListDeletedMovies(ctx context.Context, page, pageSize int) ([]*pb.Movie, int64, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	movies := make([]*models.Movie, 0, len(r.db.deleted))
+	for _, m := range r.db.deleted {
+		movies = append(movies, m)
+	}
+	sort.Slice(movies, func(i, j int) bool {
+		if c := movies[i].DeletedAt.Time.Compare(movies[j].DeletedAt.Time); c != 0 {
+			return c > 0
+		}
+		return movies[i].ID < movies[j].ID
+	})
+
+	offset := min((page-1)*pageSize, len(movies))
+	end := min(offset+pageSize, len(movies))
+	pbMovies := make([]*pb.Movie, 0, end-offset)
+	for _, m := range movies[offset:end] {
+		pbMovies = append(pbMovies, movieToProto(r.db.withGenres(m)))
+	}
+	return pbMovies, int64(len(movies)), nil
+}
+
+func (r *memoryMovieRepository) PurgeMovie(ctx context.Context, id string) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, err := r.deletedMovie(id); err != nil {
+		return err
+	}
+	r.purgeMovie(id)
+	return nil
+}
+
+func (r *memoryMovieRepository) PurgeDeletedMovies(ctx context.Context, before time.Time) (int64, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	var n int64
+	for id, m := range r.db.deleted {
+		if m.DeletedAt.Time.Before(before) {
+			r.purgeMovie(id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// purgeMovie is the in-memory counterpart of purgeMovies. The caller holds
+// the write lock.
+func (r *memoryMovieRepository) purgeMovie(id string) {
+	delete(r.db.deleted, id)
+	delete(r.db.movieGenres, id)
+	r.db.deleteCredits(func(c *models.Credit) bool { return c.MovieID == id })
+	for reviewID, rv := range r.db.reviews {
+		if rv.MovieID == id {
+			delete(r.db.reviews, reviewID)
+		}
+	}
+}
+
+// deletedMovie returns the stored movie id if it is marked deleted. The
+// caller holds the lock.
+func (r *memoryMovieRepository) deletedMovie(id string) (*models.Movie, error) {
+	if m, ok := r.db.deleted[id]; ok {
+		return m, nil
+	}
+	if _, ok := r.db.movies[id]; ok {
+		return nil, ErrMovieNotDeleted
+	}
+	return nil, ErrMovieNotFound
+}
+
+// writableMovie returns the stored movie id if it exists and, when
+// expectedVersion is not zero, still has that version. The caller holds the
+// write lock.
+func (r *memoryMovieRepository) writableMovie(id string, expectedVersion int64) (*models.Movie, error) {
+	m, ok := r.db.movies[id]
+	if !ok {
+		return nil, ErrMovieNotFound
+	}
+	if expectedVersion != 0 && m.Version != expectedVersion {
+		return nil, ErrMovieVersionMismatch
+	}
+	return m, nil
+}
+
+// setGenres is the in-memory counterpart of findOrCreateGenres followed by
+// replacing the movie's genre association. The caller holds the write lock.
+func (r *memoryMovieRepository) setGenres(movieID string, names []string) {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		slug, display := NormalizeGenre(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+
+		genre := r.db.genreBySlug(slug)
+		if genre == nil {
+			now := Now()
+			genre = &models.Genre{ID: uuid.New().String(), Name: display, Slug: slug, CreatedAt: now, UpdatedAt: now}
+			r.db.genres[genre.ID] = genre
+		}
+		ids = append(ids, genre.ID)
+	}
+	r.db.movieGenres[movieID] = ids
+}
+
+// copyMovieColumn copies one column of src to dst, zero values included. It
+// is the in-memory counterpart of movieColumnValues.
+func copyMovieColumn(dst, src *models.Movie, column string) {
+	switch column {
+	case "title":
+		dst.Title = src.Title
+	case "release_year":
+		dst.ReleaseYear = src.ReleaseYear
+	case "runtime_minutes":
+		dst.RuntimeMinutes = src.RuntimeMinutes
+	case "synopsis":
+		dst.Synopsis = src.Synopsis
+	case "original_language":
+		dst.OriginalLanguage = src.OriginalLanguage
+	case "age_rating":
+		dst.AgeRating = src.AgeRating
+	case "poster_url":
+		dst.PosterURL = src.PosterURL
+	}
+}
+
+// sortMovies applies order the way Clause does in SQL.
+func sortMovies(movies []*models.Movie, order MovieOrder) {
+	sort.SliceStable(movies, func(i, j int) bool {
+		return compareMovies(movies[i], movies[j], order) < 0
+	})
+}
+
+// compareMovies orders a and b by the order column, breaking ties by id in
+// ascending order whatever the direction.
+func compareMovies(a, b *models.Movie, order MovieOrder) int {
+	var c int
+	switch order.Key {
+	case "title":
+		c = strings.Compare(a.Title, b.Title)
+	case "release_year":
+		c = cmp.Compare(a.ReleaseYear, b.ReleaseYear)
+	case "runtime_minutes":
+		c = cmp.Compare(a.RuntimeMinutes, b.RuntimeMinutes)
+	case "created_at":
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case "updated_at":
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	case "rating":
+		c = cmp.Compare(a.AverageRating, b.AverageRating)
+	}
+	if order.Desc {
+		c = -c
+	}
+	if c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// matchesMovieFilters applies the range filters of params the way GetMovies
+// does in SQL.
+func matchesMovieFilters(m *models.Movie, params dto.MovieListParams) bool {
+	if params.MinReleaseYear != 0 && m.ReleaseYear < params.MinReleaseYear {
+		return false
+	}
+	if params.MaxReleaseYear != 0 && m.ReleaseYear > params.MaxReleaseYear {
+		return false
+	}
+	if !params.CreatedAfter.IsZero() && !m.CreatedAt.After(params.CreatedAfter) {
+		return false
+	}
+	if params.MinRating != nil && m.AverageRating < *params.MinRating {
+		return false
+	}
+	if params.MaxRating != nil && m.AverageRating > *params.MaxRating {
+		return false
+	}
+	return true
+}
+
+func genreNameContains(genres []models.Genre, search string) bool {
+	for _, g := range genres {
+		if strings.Contains(strings.ToLower(g.Name), search) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type memoryPersonRepository struct {
+	db *MemoryDB
+}
+
+func NewMemoryPersonRepository(db *MemoryDB) PersonRepository {
+	return &memoryPersonRepository{
+		db: db,
+	}
+}
+
+func (r *memoryPersonRepository) CreatePerson(ctx context.Context, person *pb.Person) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	person.Id = uuid.New().String()
+
+	data := personFromProto(person)
+	data.CreatedAt = Now()
+	data.UpdatedAt = data.CreatedAt
+	r.db.people[data.ID] = data
+
+	person.CreatedAt = timestamppb.New(data.CreatedAt)
+	person.UpdatedAt = timestamppb.New(data.UpdatedAt)
+	return nil
+}
+
+func (r *memoryPersonRepository) GetPerson(ctx context.Context, id string) (*pb.Person, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	p, ok := r.db.people[id]
+	if !ok {
+		return nil, ErrPersonNotFound
+	}
+	return personToProto(p), nil
+}
+
+func (r *memoryPersonRepository) GetPeople(ctx context.Context, page, pageSize int, search string) ([]*pb.Person, int64, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	search = strings.ToLower(search)
+	var matched []*models.Person
+	for _, p := range r.db.people {
+		if search == "" || strings.Contains(strings.ToLower(p.Name), search) {
+			matched = append(matched, p)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Name < matched[j].Name })
+
+	offset := min((page-1)*pageSize, len(matched))
+	end := min(offset+pageSize, len(matched))
+
+	people := make([]*pb.Person, 0, end-offset)
+	for _, p := range matched[offset:end] {
+		people = append(people, personToProto(p))
+	}
+	return people, int64(len(matched)), nil
+}
+
+func (r *memoryPersonRepository) UpdatePerson(ctx context.Context, person *pb.Person) (*pb.Person, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	p, ok := r.db.people[person.GetId()]
+	if !ok {
+		return nil, ErrPersonNotFound
+	}
+	if person.GetName() != "" {
+		p.Name = person.GetName()
+	}
+	if person.GetBiography() != "" {
+		p.Biography = person.GetBiography()
+	}
+	p.UpdatedAt = Now()
+	return personToProto(p), nil
+}
+
+func (r *memoryPersonRepository) DeletePerson(ctx context.Context, id string) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.people[id]; !ok {
+		return ErrPersonNotFound
+	}
+	delete(r.db.people, id)
+	r.db.deleteCredits(func(c *models.Credit) bool { return c.PersonID == id })
+	return nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type memoryReviewRepository struct {
+	db *MemoryDB
+}
+
+func NewMemoryReviewRepository(db *MemoryDB) ReviewRepository {
+	return &memoryReviewRepository{
+		db: db,
+	}
+}
+
+func (r *memoryReviewRepository) CreateReview(ctx context.Context, review *pb.Review) error {
+	if err := validateReviewScore(review.GetScore()); err != nil {
+		return err
+	}
+
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.movies[review.GetMovieId()]; !ok {
+		return ErrMovieNotFound
+	}
+
+	review.Id = uuid.New().String()
+	data := reviewFromProto(review)
+	data.CreatedAt = Now()
+	data.UpdatedAt = data.CreatedAt
+	r.db.reviews[data.ID] = data
+	r.db.refreshMovieRating(data.MovieID)
+
+	review.CreatedAt = timestamppb.New(data.CreatedAt)
+	review.UpdatedAt = timestamppb.New(data.UpdatedAt)
+	return nil
+}
+
+func (r *memoryReviewRepository) UpdateReview(ctx context.Context, review *pb.Review) (*pb.Review, error) {
+	if err := validateReviewScore(review.GetScore()); err != nil {
+		return nil, err
+	}
+
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	rv, ok := r.db.reviews[review.GetId()]
+	if !ok {
+		return nil, ErrReviewNotFound
+	}
+	rv.Score = review.GetScore()
+	rv.Text = review.GetText()
+	rv.UpdatedAt = Now()
+	r.db.refreshMovieRating(rv.MovieID)
+
+	return reviewToProto(rv), nil
+}
+
+func (r *memoryReviewRepository) DeleteReview(ctx context.Context, id string) (*pb.Review, error) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	rv, ok := r.db.reviews[id]
+	if !ok {
+		return nil, ErrReviewNotFound
+	}
+	delete(r.db.reviews, id)
+	r.db.refreshMovieRating(rv.MovieID)
+
+	return reviewToProto(rv), nil
+}
+
+func (r *memoryReviewRepository) GetReviews(ctx context.Context, movieID string, page, pageSize int) ([]*pb.Review, int64, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	var reviews []*models.Review
+	for _, rv := range r.db.reviews {
+		if rv.MovieID == movieID {
+			reviews = append(reviews, rv)
+		}
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		if !reviews[i].CreatedAt.Equal(reviews[j].CreatedAt) {
+			return reviews[i].CreatedAt.After(reviews[j].CreatedAt)
+		}
+		return reviews[i].ID < reviews[j].ID
+	})
+
+	offset := min((page-1)*pageSize, len(reviews))
+	end := min(offset+pageSize, len(reviews))
+
+	pbReviews := make([]*pb.Review, 0, end-offset)
+	for _, rv := range reviews[offset:end] {
+		pbReviews = append(pbReviews, reviewToProto(rv))
+	}
+	return pbReviews, int64(len(reviews)), nil
+}
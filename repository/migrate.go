@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	"gorm.io/gorm"
+)
+
+// MigrateLegacyGenres moves the free-text movies.genre column into the genres
+// table, splitting and normalizing each value, and then drops the column. It
+// is a no-op once the column is gone, so it is safe to run on every start.
+func MigrateLegacyGenres(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&models.Movie{}, "genre") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			ID    string
+			Genre string
+		}
+		if err := tx.Table("movies").Select("id, genre").Where("genre <> ''").Scan(&rows).Error; err != nil {
+			return fmt.Errorf("failed to read legacy genres: %w", err)
+		}
+
+		for _, row := range rows {
+			genres, err := findOrCreateGenres(tx, SplitGenres(row.Genre))
+			if err != nil {
+				return err
+			}
+			// The links are written directly so the movies' updated_at stays
+			// untouched by the migration.
+			for _, genre := range genres {
+				err := tx.Exec(
+					"INSERT OR IGNORE INTO movie_genres (movie_id, genre_id) VALUES (?, ?)",
+					row.ID, genre.ID,
+				).Error
+				if err != nil {
+					return fmt.Errorf("failed to link genres of movie %s: %w", row.ID, err)
+				}
+			}
+		}
+
+		return tx.Migrator().DropColumn(&models.Movie{}, "genre")
+	})
+}
+
+// MigrateMovieTitles fills movies.normalized_title and creates the unique
+// index over it and release_year among live movies. A movie that duplicates
+// an older one is left without a normalized title, which the index ignores,
+// so existing duplicates do not block the migration. It returns how many such
+// movies there are; FindDuplicateMovies reports them. It is safe to run on
+// every start.
+func MigrateMovieTitles(db *gorm.DB) (int, error) {
+	type titleYear struct {
+		title string
+		year  int32
+	}
+
+	duplicates := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Rows from before release_year existed hold NULL, which the index
+		// would treat as distinct from every other year.
+		if err := tx.Exec("UPDATE movies SET release_year = 0 WHERE release_year IS NULL").Error; err != nil {
+			return fmt.Errorf("failed to fill release years: %w", err)
+		}
+
+		var movies []*models.Movie
+		err := tx.Unscoped().
+			Select("id", "title", "release_year", "normalized_title", "deleted_at").
+			Order("created_at, id").
+			Find(&movies).Error
+		if err != nil {
+			return fmt.Errorf("failed to read movie titles: %w", err)
+		}
+
+		taken := make(map[titleYear]bool)
+		for _, m := range movies {
+			if m.NormalizedTitle != nil && !m.DeletedAt.Valid {
+				taken[titleYear{*m.NormalizedTitle, m.ReleaseYear}] = true
+			}
+		}
+		for _, m := range movies {
+			if m.NormalizedTitle != nil {
+				continue
+			}
+			key := titleYear{NormalizeTitle(m.Title), m.ReleaseYear}
+			if !m.DeletedAt.Valid {
+				if taken[key] {
+					duplicates++
+					continue
+				}
+				taken[key] = true
+			}
+			err := tx.Unscoped().Model(&models.Movie{}).Where("id = ?", m.ID).UpdateColumn("normalized_title", key.title).Error
+			if err != nil {
+				return fmt.Errorf("failed to normalize title of movie %s: %w", m.ID, err)
+			}
+		}
+
+		return tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_movies_title_year ON movies (normalized_title, release_year) WHERE deleted_at IS NULL").Error
+	})
+	return duplicates, err
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// MovieEventRepository reads the movie change outbox. Events are recorded by
+// the other repositories, inside the transactions that make the changes.
+type MovieEventRepository interface {
+	// GetMovieEvents returns at most limit events with a revision greater
+	// than after, oldest first. Their Movie is left unset.
+	GetMovieEvents(ctx context.Context, after int64, limit int) ([]*pb.MovieEvent, error)
+	// LatestMovieRevision returns the revision of the newest event, or 0
+	// when nothing has been recorded yet.
+	LatestMovieRevision(ctx context.Context) (int64, error)
+	// PruneMovieEvents deletes the events recorded before the given time and
+	// returns how many there were. The newest event is always kept, so
+	// revisions keep counting up from it.
+	PruneMovieEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+type movieEventRepository struct {
+	db *gorm.DB
+}
+
+func NewMovieEventRepository(db *gorm.DB) MovieEventRepository {
+	return &movieEventRepository{
+		db: db,
+	}
+}
+
+// GetMovieEvents relies on SQLite running one write transaction at a time:
+// revisions become visible in order, so a reader never skips one that is
+// committed late.
+func (r *movieEventRepository) GetMovieEvents(ctx context.Context, after int64, limit int) ([]*pb.MovieEvent, error) {
+	var events []*models.MovieEvent
+	err := r.db.WithContext(ctx).
+		Where("revision > ?", after).
+		Order("revision").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pbEvents := make([]*pb.MovieEvent, len(events))
+	for i, e := range events {
+		pbEvents[i] = movieEventToProto(e)
+	}
+	return pbEvents, nil
+}
+
+func (r *movieEventRepository) LatestMovieRevision(ctx context.Context) (int64, error) {
+	var revision int64
+	err := r.db.WithContext(ctx).Model(&models.MovieEvent{}).Select("COALESCE(MAX(revision), 0)").Scan(&revision).Error
+	return revision, err
+}
+
+func (r *movieEventRepository) PruneMovieEvents(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).
+		Where("created_at < ? AND revision < (SELECT MAX(revision) FROM movie_events)", dbTime(before)).
+		Delete(&models.MovieEvent{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
+// recordMovieEvents appends an event of the given type for every movie in
+// ids, a slice of IDs or a subquery selecting them. Only movies that exist
+// and are not deleted are recorded, so a delete has to be recorded before the
+// row goes and an undelete after it is back.
+func recordMovieEvents(tx *gorm.DB, eventType pb.MovieEventType, ids interface{}) error {
+	err := tx.Exec(`INSERT INTO movie_events (movie_id, type, created_at)
+		SELECT id, ?, ? FROM movies WHERE id IN (?) AND deleted_at IS NULL`, eventType.String(), Now(), ids).Error
+	if err != nil {
+		return fmt.Errorf("failed to record movie event: %w", err)
+	}
+	return nil
+}
+
+func movieEventToProto(e *models.MovieEvent) *pb.MovieEvent {
+	return &pb.MovieEvent{
+		Revision:  e.Revision,
+		Type:      pb.MovieEventType(pb.MovieEventType_value[e.Type]),
+		MovieId:   e.MovieID,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
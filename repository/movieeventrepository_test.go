@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+)
+
+func TestPruneMovieEvents(t *testing.T) {
+	ctx := context.Background()
+	memoryDB := NewMemoryDB()
+	sqlDB := openTestDB(t)
+	backends := map[string]struct {
+		movies MovieRepository
+		events MovieEventRepository
+	}{
+		"memory": {NewMemoryMovieRepository(memoryDB), NewMemoryMovieEventRepository(memoryDB)},
+		"sqlite": {NewMovieRepository(sqlDB), NewMovieEventRepository(sqlDB)},
+	}
+
+	for name, b := range backends {
+		for _, title := range []string{"Heat", "Ronin", "Casino"} {
+			if err := b.movies.CreateMovie(ctx, &pb.Movie{Title: title}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		latest, err := b.events.LatestMovieRevision(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n, err := b.events.PruneMovieEvents(ctx, Now().Add(-time.Hour)); err != nil || n != 0 {
+			t.Errorf("%s: pruning nothing = %d, %v", name, n, err)
+		}
+
+		// Pruning everything keeps the newest event.
+		n, err := b.events.PruneMovieEvents(ctx, Now().Add(time.Hour))
+		if err != nil || n != 2 {
+			t.Errorf("%s: pruning everything = %d, %v, want 2", name, n, err)
+		}
+		events, err := b.events.GetMovieEvents(ctx, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 1 || events[0].GetRevision() != latest {
+			t.Errorf("%s: events left = %v, want revision %d only", name, events, latest)
+		}
+
+		// Revisions carry on from the kept event.
+		if err := b.movies.CreateMovie(ctx, &pb.Movie{Title: "Arrival"}); err != nil {
+			t.Fatal(err)
+		}
+		if next, _ := b.events.LatestMovieRevision(ctx); next != latest+1 {
+			t.Errorf("%s: revision after pruning = %d, want %d", name, next, latest+1)
+		}
+	}
+}
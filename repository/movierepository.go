@@ -4,68 +4,249 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/renaldyhidayatt/movie_grpc/dto"
 	"github.com/renaldyhidayatt/movie_grpc/models"
 	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+var (
+	ErrMovieNotFound = newResourceError(ErrNotFound, "movie", "movie not found")
+	// ErrMovieVersionMismatch means the movie exists but has moved past the
+	// version the caller expected.
+	ErrMovieVersionMismatch = newResourceError(ErrFailedPrecondition, "movie", "movie version does not match")
+	// ErrMovieNotDeleted means the movie exists but has not been deleted, so
+	// there is nothing to undelete or purge.
+	ErrMovieNotDeleted = newResourceError(ErrFailedPrecondition, "movie", "movie is not deleted")
+	// ErrIdempotencyKeyReused means the idempotency key was first sent with
+	// a different request, so replaying that request's movie would be wrong.
+	ErrIdempotencyKeyReused = newFieldError("request_id", "idempotency key was already used for a different request")
+)
+
 type MovieRepository interface {
+	// CreateMovie, UpdateMovie, UndeleteMovie and the batch writes fail with
+	// an ErrAlreadyExists error naming the other movie when a live movie
+	// already has the same normalized title and release year.
 	CreateMovie(ctx context.Context, movie *pb.Movie) error
+	// CreateMovieIdempotent creates movie like CreateMovie and records it
+	// under key in the same transaction. Called again with the same key and
+	// request hash, it creates nothing and returns the recorded movie with
+	// replayed set. A key reused for another request fails with
+	// ErrIdempotencyKeyReused.
+	CreateMovieIdempotent(ctx context.Context, movie *pb.Movie, key dto.IdempotencyKey) (created *pb.Movie, replayed bool, err error)
 	GetMovie(ctx context.Context, id string) (*pb.Movie, error)
-	GetMovies(ctx context.Context, page, pageSize int, search string) (*dto.MovieListResult, error)
-	UpdateMovie(ctx context.Context, movie *pb.Movie) (*pb.Movie, error)
-	DeleteMovie(ctx context.Context, id string) error
+	// GetMoviesByID loads several movies in one query. IDs that do not exist
+	// are absent from the result.
+	GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error)
+	GetMovies(ctx context.Context, params dto.MovieListParams) (*dto.MovieListResult, error)
+	SearchMovies(ctx context.Context, query string, page, pageSize int) ([]*pb.MovieSearchResult, int64, error)
+	// UpdateMovie writes the fields of movie that mask lists, or its
+	// non-empty fields when mask is zero. UpdateMovie and DeleteMovie fail
+	// with ErrMovieVersionMismatch when expectedVersion is not zero and the
+	// stored movie has another version.
+	UpdateMovie(ctx context.Context, movie *pb.Movie, mask MovieUpdateMask, expectedVersion int64) (*pb.Movie, error)
+	// DeleteMovie only marks the movie deleted, which hides it from every
+	// other read. UndeleteMovie brings it back with its credits, reviews and
+	// genres, and PurgeMovie removes it for good.
+	DeleteMovie(ctx context.Context, id string, expectedVersion int64) error
+	UndeleteMovie(ctx context.Context, id string) (*pb.Movie, error)
+	ListDeletedMovies(ctx context.Context, page, pageSize int) ([]*pb.Movie, int64, error)
+	PurgeMovie(ctx context.Context, id string) error
+	// PurgeDeletedMovies purges every movie deleted before the given time and
+	// returns how many there were.
+	PurgeDeletedMovies(ctx context.Context, before time.Time) (int64, error)
+	// CreateMovies creates movies under fresh IDs in one transaction, and
+	// DeleteMovies deletes ids in one transaction. The returned slice holds
+	// the error of each item, if any; a failing item does not stop the rest.
+	CreateMovies(ctx context.Context, movies []*pb.Movie) ([]error, error)
+	DeleteMovies(ctx context.Context, ids []string) ([]error, error)
+	// StreamMovies hands the whole catalog to fn batchSize movies at a time,
+	// stopping at the first error fn returns.
+	StreamMovies(ctx context.Context, batchSize int, fn func([]*pb.Movie) error) error
+	// ImportMovies upserts movies in one transaction. A movie whose Id exists
+	// is updated, any other is created under its Id or a fresh one. Failing
+	// rows are reported in the results without aborting the rest.
+	ImportMovies(ctx context.Context, movies []*pb.Movie) ([]dto.MovieImportResult, error)
+	// FindDuplicateMovies returns at most limit pairs of live movies whose
+	// normalized titles are at least minSimilarity alike, from 0 to 1, and
+	// whose release years do not differ, most alike first.
+	FindDuplicateMovies(ctx context.Context, minSimilarity float64, limit int) ([]dto.MovieDuplicate, error)
 }
 
 type movieRepository struct {
 	db *gorm.DB
+	// search is set when the movies_fts index is usable. Without it search
+	// falls back to LIKE and ranking happens in process.
+	search bool
 }
 
 func NewMovieRepository(db *gorm.DB) MovieRepository {
 	return &movieRepository{
-		db: db,
+		db:     db,
+		search: hasMovieSearch(db),
 	}
 }
 
 func (r *movieRepository) CreateMovie(ctx context.Context, movie *pb.Movie) error {
 	movie.Id = uuid.New().String()
 
-	data := models.Movie{
-		ID:    movie.GetId(),
-		Title: movie.GetTitle(),
-		Genre: movie.GetGenre(),
+	return transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return r.createMovie(tx, movie)
+	})
+}
+
+// CreateMovieIdempotent also drops every key older than key.Since, so the
+// table only holds the keys of the current window.
+func (r *movieRepository) CreateMovieIdempotent(ctx context.Context, movie *pb.Movie, key dto.IdempotencyKey) (*pb.Movie, bool, error) {
+	var (
+		created  *pb.Movie
+		replayed bool
+	)
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := tx.Where("created_at < ?", dbTime(key.Since)).Delete(&models.IdempotencyKey{}).Error; err != nil {
+			return err
+		}
+
+		var err error
+		created, replayed, err = idempotentResponse(tx, key)
+		if err != nil || replayed {
+			return err
+		}
+
+		movie.Id = uuid.New().String()
+		if err := r.createMovie(tx, movie); err != nil {
+			return err
+		}
+		response, err := proto.Marshal(movie)
+		if err != nil {
+			return err
+		}
+		created = movie
+		return tx.Create(&models.IdempotencyKey{
+			Key:         key.Key,
+			RequestHash: key.RequestHash,
+			Response:    response,
+		}).Error
+	})
+	if errors.Is(err, ErrConflict) || errors.Is(err, ErrAlreadyExists) {
+		// A concurrent call with the same key may have committed between the
+		// lookup and the inserts, so this one collided with its key or with
+		// the movie it created. Answer the way a later retry would.
+		earlier, ok, lookupErr := idempotentResponse(r.db.WithContext(ctx), key)
+		switch {
+		case errors.Is(lookupErr, ErrIdempotencyKeyReused):
+			return nil, false, lookupErr
+		case lookupErr == nil && ok:
+			return earlier, true, nil
+		}
+	}
+	if err != nil {
+		return nil, false, err
 	}
+	return created, replayed, nil
+}
 
-	res := r.db.Create(&data)
+// idempotentResponse returns the movie recorded under key, if there is one.
+// It fails with ErrIdempotencyKeyReused when the key was recorded for
+// another request.
+func idempotentResponse(tx *gorm.DB, key dto.IdempotencyKey) (*pb.Movie, bool, error) {
+	var record models.IdempotencyKey
+	res := tx.Limit(1).Find(&record, "key = ?", key.Key)
+	if res.Error != nil {
+		return nil, false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, false, nil
+	}
+	if record.RequestHash != key.RequestHash {
+		return nil, false, ErrIdempotencyKeyReused
+	}
+	movie := &pb.Movie{}
+	if err := proto.Unmarshal(record.Response, movie); err != nil {
+		return nil, false, err
+	}
+	return movie, true, nil
+}
+
+// createMovie inserts movie under its Id and fills in the stored genres and
+// timestamps.
+func (r *movieRepository) createMovie(tx *gorm.DB, movie *pb.Movie) error {
+	data := movieFromProto(movie)
+	data.Version = 1
+	key, err := movieTitleKey(tx, data.ID, data.Title, data.ReleaseYear)
+	if err != nil {
+		return err
+	}
+	data.NormalizedTitle = &key
+
+	genres, err := findOrCreateGenres(tx, movieGenreNames(movie.GetGenres(), movie.GetGenre()))
+	if err != nil {
+		return err
+	}
+	data.Genres = genres
+
+	res := tx.Omit("Genres.*").Create(data)
+	if res.Error != nil {
+		return res.Error
+	}
 	if res.RowsAffected == 0 {
 		return errors.New("movie creation unsuccessful")
 	}
+	if err := r.syncSearch(tx, []string{data.ID}); err != nil {
+		return err
+	}
+	if err := recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_CREATED, []string{data.ID}); err != nil {
+		return err
+	}
+
+	movie.Genres = genreNamesOf(data.Genres)
+	movie.Genre = strings.Join(movie.Genres, ", ")
+	movie.Version = data.Version
+	movie.CreatedAt = timestamppb.New(data.CreatedAt)
+	movie.UpdatedAt = timestamppb.New(data.UpdatedAt)
 	return nil
 }
 
 func (r *movieRepository) GetMovie(ctx context.Context, id string) (*pb.Movie, error) {
 	var movie models.Movie
-	res := r.db.Find(&movie, "id = ?", id)
+	res := r.db.WithContext(ctx).Preload("Genres").Find(&movie, "id = ?", id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
 	if res.RowsAffected == 0 {
-		return nil, errors.New("movie not found")
+		return nil, ErrMovieNotFound
 	}
-	return &pb.Movie{
-		Id:    movie.ID,
-		Title: movie.Title,
-		Genre: movie.Genre,
-	}, nil
+	return movieToProto(&movie), nil
+}
+
+func (r *movieRepository) GetMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error) {
+	var movies []*models.Movie
+	if err := r.db.WithContext(ctx).Preload("Genres").Find(&movies, "id IN ?", ids).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*pb.Movie, len(movies))
+	for _, m := range movies {
+		result[m.ID] = movieToProto(m)
+	}
+	return result, nil
 }
 
-func (r *movieRepository) GetMovies(ctx context.Context, page, pageSize int, search string) (*dto.MovieListResult, error) {
+func (r *movieRepository) GetMovies(ctx context.Context, params dto.MovieListParams) (*dto.MovieListResult, error) {
 	var (
 		movies       []*models.Movie
 		totalRecords int64
 	)
 
+	page, pageSize, search := params.Page, params.PageSize, params.Search
+
 	if page < 1 {
 		page = 1
 	}
@@ -76,55 +257,641 @@ func (r *movieRepository) GetMovies(ctx context.Context, page, pageSize int, sea
 	offset := (page - 1) * pageSize
 	query := r.db.WithContext(ctx).Model(&models.Movie{})
 
-	if search != "" {
+	if terms := searchTerms(search); r.search && len(terms) > 0 {
+		query = query.Where("id IN (?)", r.searchMovieIDs(terms))
+	} else if search != "" {
 		searchPattern := "%" + strings.ToLower(search) + "%"
-		query = query.Where("LOWER(title) LIKE ? OR LOWER(genre) LIKE ?", searchPattern, searchPattern)
+		query = query.Where(
+			"LOWER(title) LIKE ? OR id IN (?)",
+			searchPattern,
+			r.genreMovieIDs().Where("LOWER(genres.name) LIKE ?", searchPattern),
+		)
 	}
 
-	if err := query.Count(&totalRecords).Error; err != nil {
-		return nil, fmt.Errorf("failed to count movies: %w", err)
+	if slug, _ := NormalizeGenre(params.Genre); slug != "" {
+		query = query.Where("id IN (?)", r.genreMovieIDs().Where("genres.slug = ?", slug))
+	}
+	if params.MinReleaseYear != 0 {
+		query = query.Where("release_year >= ?", params.MinReleaseYear)
+	}
+	if params.MaxReleaseYear != 0 {
+		query = query.Where("release_year <= ?", params.MaxReleaseYear)
+	}
+	if !params.CreatedAfter.IsZero() {
+		query = query.Where("created_at > ?", dbTime(params.CreatedAfter))
+	}
+	if params.MinRating != nil {
+		query = query.Where("average_rating >= ?", *params.MinRating)
+	}
+	if params.MaxRating != nil {
+		query = query.Where("average_rating <= ?", *params.MaxRating)
 	}
 
-	if err := query.Limit(pageSize).Offset(offset).Find(&movies).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch movies: %w", err)
+	order, err := ParseMovieOrder(params.OrderBy)
+	if err != nil {
+		return nil, err
 	}
-	if len(movies) == 0 {
-		return nil, errors.New("movies not found")
+
+	if params.IncludeTotal {
+		if err := query.Count(&totalRecords).Error; err != nil {
+			return nil, fmt.Errorf("failed to count movies: %w", err)
+		}
 	}
 
-	pbMovies := make([]*pb.Movie, len(movies))
-	for i, m := range movies {
-		pbMovies[i] = &pb.Movie{
-			Id:    m.ID,
-			Title: m.Title,
-			Genre: m.Genre,
+	if params.PageToken != "" {
+		cursor, err := decodeMovieCursor(params.PageToken, params, order)
+		if err != nil {
+			return nil, err
+		}
+		value := movieSortValue(cursor, order.Key)
+		if t, ok := value.(time.Time); ok {
+			value = dbTime(t)
 		}
+		op := ">"
+		if order.Desc {
+			op = "<"
+		}
+		// Ties on the sort column are broken by id, ascending in both directions,
+		// matching Clause.
+		query = query.Where(
+			fmt.Sprintf("%s %s ? OR (%s = ? AND id > ?)", order.Column, op, order.Column),
+			value, value, cursor.ID,
+		)
+		offset = 0
+	}
+
+	// One extra row tells whether another page follows without a COUNT.
+	err = query.Preload("Genres").Order(order.Clause()).Limit(pageSize + 1).Offset(offset).Find(&movies).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch movies: %w", err)
 	}
 
-	return &dto.MovieListResult{
-		Movies:       pbMovies,
+	result := &dto.MovieListResult{
 		TotalRecords: totalRecords,
-	}, nil
+	}
+	if len(movies) > pageSize {
+		movies = movies[:pageSize]
+		result.NextPageToken, err = encodeMovieCursor(params, order, movies[pageSize-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Movies = make([]*pb.Movie, len(movies))
+	for i, m := range movies {
+		result.Movies[i] = movieToProto(m)
+	}
+	return result, nil
 }
 
-func (r *movieRepository) UpdateMovie(ctx context.Context, movie *pb.Movie) (*pb.Movie, error) {
+func (r *movieRepository) UpdateMovie(ctx context.Context, movie *pb.Movie, mask MovieUpdateMask, expectedVersion int64) (*pb.Movie, error) {
 	var m models.Movie
-	res := r.db.Model(&m).Where("id=?", movie.Id).Updates(models.Movie{Title: movie.Title, Genre: movie.Genre})
+
+	// The row is read back inside the transaction, so the caller gets exactly
+	// what this update committed, generated columns included.
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := r.updateMovie(tx, movie, mask, expectedVersion); err != nil {
+			return err
+		}
+		return tx.Preload("Genres").First(&m, "id = ?", movie.GetId()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return movieToProto(&m), nil
+}
+
+// updateMovie writes the fields of movie selected by mask to the stored row
+// and bumps its version. With a zero mask it writes the non-zero fields, and
+// the genres when any are given.
+func (r *movieRepository) updateMovie(tx *gorm.DB, movie *pb.Movie, mask MovieUpdateMask, expectedVersion int64) error {
+	changes := movieFromProto(movie)
+	names := movieGenreNames(movie.GetGenres(), movie.GetGenre())
+	if mask.IsZero() {
+		mask = nonZeroMovieUpdateMask(changes, names)
+	}
+	if err := checkRequiredMovieColumns(changes, mask); err != nil {
+		return err
+	}
+
+	// A map writes zero values too, which is what lets a mask clear a field.
+	// updated_at and version always change, so every update touches the row.
+	values := movieColumnValues(changes, mask.Columns)
+	values["updated_at"] = Now()
+	values["version"] = gorm.Expr("version + 1")
+	if slices.Contains(mask.Columns, "title") || slices.Contains(mask.Columns, "release_year") {
+		key, err := r.updatedTitleKey(tx, movie.Id, changes, mask)
+		if err != nil {
+			return err
+		}
+		values["normalized_title"] = key
+	}
+
+	query := tx.Model(&models.Movie{}).Where("id = ?", movie.Id)
+	if expectedVersion != 0 {
+		query = query.Where("version = ?", expectedVersion)
+	}
+	res := query.Updates(values)
+	if res.Error != nil {
+		return res.Error
+	}
 	if res.RowsAffected == 0 {
-		return nil, errors.New("movie not found")
+		return missingMovieError(tx, movie.Id)
 	}
-	return &pb.Movie{
-		Id:    m.ID,
-		Title: m.Title,
-		Genre: m.Genre,
-	}, nil
+
+	if mask.Genres {
+		genres, err := findOrCreateGenres(tx, names)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Movie{ID: movie.Id}).Omit("Genres.*").Association("Genres").Replace(genres); err != nil {
+			return err
+		}
+	}
+	if err := r.syncSearch(tx, []string{movie.Id}); err != nil {
+		return err
+	}
+	return recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, []string{movie.Id})
 }
 
-func (r *movieRepository) DeleteMovie(ctx context.Context, id string) error {
-	var movie models.Movie
-	res := r.db.Where("id = ?", id).Delete(&movie)
+// updatedTitleKey is movieTitleKey for an update of movie id that writes the
+// title or release year in mask, the other one keeping its stored value.
+func (r *movieRepository) updatedTitleKey(tx *gorm.DB, id string, changes *models.Movie, mask MovieUpdateMask) (string, error) {
+	var stored models.Movie
+	if err := tx.Select("title", "release_year").Limit(1).Find(&stored, "id = ?", id).Error; err != nil {
+		return "", err
+	}
+	for _, column := range mask.Columns {
+		copyMovieColumn(&stored, changes, column)
+	}
+	return movieTitleKey(tx, id, stored.Title, stored.ReleaseYear)
+}
+
+func (r *movieRepository) DeleteMovie(ctx context.Context, id string, expectedVersion int64) error {
+	return transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return r.deleteMovie(tx, id, expectedVersion)
+	})
+}
+
+// deleteMovie marks the movie deleted and drops it from the search index. Its
+// credits, reviews and genre links stay until the movie is purged.
+func (r *movieRepository) deleteMovie(tx *gorm.DB, id string, expectedVersion int64) error {
+	if err := recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_DELETED, []string{id}); err != nil {
+		return err
+	}
+
+	query := tx.Where("id = ?", id)
+	if expectedVersion != 0 {
+		query = query.Where("version = ?", expectedVersion)
+	}
+	res := query.Delete(&models.Movie{})
+	if res.Error != nil {
+		return res.Error
+	}
 	if res.RowsAffected == 0 {
-		return errors.New("movie not found")
+		return missingMovieError(tx, id)
 	}
-	return nil
+	return r.syncSearch(tx, []string{id})
+}
+
+// UndeleteMovie clears the deletion mark and bumps the version, so writes
+// conditioned on the version from before the delete fail.
+func (r *movieRepository) UndeleteMovie(ctx context.Context, id string) (*pb.Movie, error) {
+	var m models.Movie
+
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		// A movie created since the delete may have taken the title and year.
+		var stored models.Movie
+		if err := tx.Unscoped().Select("title", "release_year").Limit(1).Find(&stored, "id = ?", id).Error; err != nil {
+			return err
+		}
+		key, err := movieTitleKey(tx, id, stored.Title, stored.ReleaseYear)
+		if err != nil {
+			return err
+		}
+
+		res := tx.Unscoped().Model(&models.Movie{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Updates(map[string]interface{}{
+				"deleted_at":       nil,
+				"updated_at":       Now(),
+				"version":          gorm.Expr("version + 1"),
+				"normalized_title": key,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return notDeletedMovieError(tx, id)
+		}
+		if err := r.syncSearch(tx, []string{id}); err != nil {
+			return err
+		}
+		if err := recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_CREATED, []string{id}); err != nil {
+			return err
+		}
+		return tx.Preload("Genres").First(&m, "id = ?", id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return movieToProto(&m), nil
+}
+
+func (r *movieRepository) ListDeletedMovies(ctx context.Context, page, pageSize int) ([]*pb.Movie, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&models.Movie{}).Where("deleted_at IS NOT NULL")
+
+	var totalRecords int64
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count deleted movies: %w", err)
+	}
+
+	var movies []*models.Movie
+	err := query.Preload("Genres").Order("deleted_at DESC, id").Limit(pageSize).Offset((page - 1) * pageSize).Find(&movies).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch deleted movies: %w", err)
+	}
+
+	pbMovies := make([]*pb.Movie, len(movies))
+	for i, m := range movies {
+		pbMovies[i] = movieToProto(m)
+	}
+	return pbMovies, totalRecords, nil
+}
+
+func (r *movieRepository) PurgeMovie(ctx context.Context, id string) error {
+	return transaction(ctx, r.db, func(tx *gorm.DB) error {
+		n, err := purgeMovies(tx, tx.Unscoped().Model(&models.Movie{}).Select("id").Where("id = ? AND deleted_at IS NOT NULL", id))
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return notDeletedMovieError(tx, id)
+		}
+		return nil
+	})
+}
+
+func (r *movieRepository) PurgeDeletedMovies(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		ids := tx.Unscoped().Model(&models.Movie{}).Select("id").Where("deleted_at < ?", dbTime(before))
+
+		var err error
+		n, err = purgeMovies(tx, ids)
+		return err
+	})
+	return n, err
+}
+
+// purgeMovies removes movies for good with their credits, reviews and genre
+// links, and returns how many movies went. ids is a subquery selecting them;
+// it is evaluated once per statement, so the movies are deleted last.
+func purgeMovies(tx *gorm.DB, ids *gorm.DB) (int64, error) {
+	if err := tx.Where("movie_id IN (?)", ids).Delete(&models.Credit{}).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Where("movie_id IN (?)", ids).Delete(&models.Review{}).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Exec("DELETE FROM movie_genres WHERE movie_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+	res := tx.Unscoped().Where("id IN (?)", ids).Delete(&models.Movie{})
+	return res.RowsAffected, res.Error
+}
+
+// CreateMovies creates every movie inside its own savepoint, so a failing
+// movie is rolled back without touching the others.
+func (r *movieRepository) CreateMovies(ctx context.Context, movies []*pb.Movie) ([]error, error) {
+	errs := make([]error, len(movies))
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		for i, movie := range movies {
+			movie.Id = uuid.New().String()
+			errs[i] = conflictError(tx.Transaction(func(tx *gorm.DB) error {
+				return r.createMovie(tx, movie)
+			}))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+func (r *movieRepository) DeleteMovies(ctx context.Context, ids []string) ([]error, error) {
+	errs := make([]error, len(ids))
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		for i, id := range ids {
+			errs[i] = conflictError(tx.Transaction(func(tx *gorm.DB) error {
+				return r.deleteMovie(tx, id, 0)
+			}))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+// missingMovieError explains why a conditional write on id matched no row.
+func missingMovieError(tx *gorm.DB, id string) error {
+	var count int64
+	if err := tx.Model(&models.Movie{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrMovieVersionMismatch
+	}
+	return ErrMovieNotFound
+}
+
+// notDeletedMovieError explains why id matched no deleted movie.
+func notDeletedMovieError(tx *gorm.DB, id string) error {
+	var count int64
+	if err := tx.Model(&models.Movie{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrMovieNotDeleted
+	}
+	return ErrMovieNotFound
+}
+
+func (r *movieRepository) StreamMovies(ctx context.Context, batchSize int, fn func([]*pb.Movie) error) error {
+	var movies []*models.Movie
+	res := r.db.WithContext(ctx).Preload("Genres").FindInBatches(&movies, batchSize, func(tx *gorm.DB, batch int) error {
+		pbMovies := make([]*pb.Movie, len(movies))
+		for i, m := range movies {
+			pbMovies[i] = movieToProto(m)
+		}
+		return fn(pbMovies)
+	})
+	return res.Error
+}
+
+func (r *movieRepository) ImportMovies(ctx context.Context, movies []*pb.Movie) ([]dto.MovieImportResult, error) {
+	results := make([]dto.MovieImportResult, len(movies))
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		for i, movie := range movies {
+			results[i] = r.importMovie(tx, movie)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// importMovie upserts one movie inside a savepoint, so a failing row is
+// rolled back on its own.
+func (r *movieRepository) importMovie(tx *gorm.DB, movie *pb.Movie) dto.MovieImportResult {
+	var result dto.MovieImportResult
+	result.Err = conflictError(tx.Transaction(func(tx *gorm.DB) error {
+		// A deleted movie keeps its id, so importing it fails the way updating
+		// it would instead of creating it a second time.
+		var count int64
+		if movie.GetId() != "" {
+			if err := tx.Unscoped().Model(&models.Movie{}).Where("id = ?", movie.GetId()).Count(&count).Error; err != nil {
+				return err
+			}
+		}
+		if count > 0 {
+			return r.updateMovie(tx, movie, MovieUpdateMask{}, 0)
+		}
+
+		if movie.GetId() == "" {
+			movie.Id = uuid.New().String()
+		}
+		result.Created = true
+		return r.createMovie(tx, movie)
+	}))
+	return result
+}
+
+func (r *movieRepository) FindDuplicateMovies(ctx context.Context, minSimilarity float64, limit int) ([]dto.MovieDuplicate, error) {
+	var movies []*models.Movie
+	if err := r.db.WithContext(ctx).Select("id", "title", "release_year").Order("created_at, id").Find(&movies).Error; err != nil {
+		return nil, fmt.Errorf("failed to read movie titles: %w", err)
+	}
+
+	pairs := findDuplicatePairs(movies, minSimilarity, limit)
+	ids := make([]string, 0, 2*len(pairs))
+	for _, p := range pairs {
+		ids = append(ids, p.first, p.second)
+	}
+	found, err := r.GetMoviesByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates := make([]dto.MovieDuplicate, len(pairs))
+	for i, p := range pairs {
+		duplicates[i] = dto.MovieDuplicate{
+			Movie:      found[p.first],
+			Duplicate:  found[p.second],
+			Similarity: p.similarity,
+		}
+	}
+	return duplicates, nil
+}
+
+// SearchMovies ranks movies against query with the full-text index, or in
+// process when the index is unavailable.
+func (r *movieRepository) SearchMovies(ctx context.Context, query string, page, pageSize int) ([]*pb.MovieSearchResult, int64, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, 0, ErrInvalidSearchQuery
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if !r.search {
+		return r.searchMoviesLike(ctx, terms, page, pageSize)
+	}
+
+	db := r.db.WithContext(ctx)
+	match := ftsMatch(terms)
+
+	var totalRecords int64
+	if err := db.Table("movies_fts").Where("movies_fts MATCH ?", match).Count(&totalRecords).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+
+	var hits []struct {
+		MovieID        string
+		Score          float64
+		TitleHighlight string
+		Snippet        string
+	}
+	err := db.Raw(`SELECT movie_id,
+			-bm25(movies_fts, 0, ?, ?, ?) AS score,
+			highlight(movies_fts, 1, ?, ?) AS title_highlight,
+			snippet(movies_fts, 3, ?, ?, '…', ?) AS snippet
+		FROM movies_fts WHERE movies_fts MATCH ?
+		ORDER BY score DESC, title LIMIT ? OFFSET ?`,
+		titleWeight, genresWeight, synopsisWeight,
+		highlightStart, highlightEnd,
+		highlightStart, highlightEnd, snippetWords,
+		match, pageSize, (page-1)*pageSize,
+	).Scan(&hits).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search movies: %w", err)
+	}
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.MovieID
+	}
+	var movies []*models.Movie
+	if err := db.Preload("Genres").Where("id IN ?", ids).Find(&movies).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch movies: %w", err)
+	}
+	byID := make(map[string]*models.Movie, len(movies))
+	for _, m := range movies {
+		byID[m.ID] = m
+	}
+
+	results := make([]*pb.MovieSearchResult, 0, len(hits))
+	for _, hit := range hits {
+		m, ok := byID[hit.MovieID]
+		if !ok {
+			continue
+		}
+		results = append(results, &pb.MovieSearchResult{
+			Movie:          movieToProto(m),
+			Score:          hit.Score,
+			TitleHighlight: hit.TitleHighlight,
+			Snippet:        hit.Snippet,
+		})
+	}
+	return results, totalRecords, nil
+}
+
+// searchMoviesLike narrows the candidates down with LIKE on every term and
+// ranks them in process.
+func (r *movieRepository) searchMoviesLike(ctx context.Context, terms []string, page, pageSize int) ([]*pb.MovieSearchResult, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.Movie{})
+	for _, term := range terms {
+		pattern := "%" + term + "%"
+		query = query.Where(
+			"LOWER(title) LIKE ? OR LOWER(synopsis) LIKE ? OR id IN (?)",
+			pattern, pattern,
+			r.genreMovieIDs().Where("LOWER(genres.name) LIKE ?", pattern),
+		)
+	}
+
+	var movies []*models.Movie
+	if err := query.Preload("Genres").Find(&movies).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search movies: %w", err)
+	}
+
+	results := rankMovies(movies, terms)
+	offset := min((page-1)*pageSize, len(results))
+	end := min(offset+pageSize, len(results))
+	return results[offset:end], int64(len(results)), nil
+}
+
+// searchMovieIDs selects the ids of movies matching every term in the
+// full-text index.
+func (r *movieRepository) searchMovieIDs(terms []string) *gorm.DB {
+	return r.db.Table("movies_fts").Select("movie_id").Where("movies_fts MATCH ?", ftsMatch(terms))
+}
+
+func (r *movieRepository) syncSearch(tx *gorm.DB, ids interface{}) error {
+	if !r.search {
+		return nil
+	}
+	return syncMovieSearch(tx, ids)
+}
+
+// genreMovieIDs selects the ids of movies joined to genres; callers narrow it
+// down with a condition on the genres table.
+func (r *movieRepository) genreMovieIDs() *gorm.DB {
+	return r.db.Table("movie_genres").
+		Select("movie_genres.movie_id").
+		Joins("JOIN genres ON genres.id = movie_genres.genre_id")
+}
+
+// findOrCreateGenres resolves free-text genre names to genre rows, creating
+// the ones that do not exist yet. Names that normalize to the same slug are
+// collapsed into one genre.
+func findOrCreateGenres(tx *gorm.DB, names []string) ([]models.Genre, error) {
+	var genres []models.Genre
+	seen := make(map[string]bool)
+	for _, name := range names {
+		slug, display := NormalizeGenre(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+
+		var genre models.Genre
+		attrs := models.Genre{ID: uuid.New().String(), Name: display}
+		if err := tx.Where(models.Genre{Slug: slug}).Attrs(attrs).FirstOrCreate(&genre).Error; err != nil {
+			return nil, fmt.Errorf("failed to resolve genre %q: %w", name, err)
+		}
+		genres = append(genres, genre)
+	}
+	return genres, nil
+}
+
+func movieToProto(m *models.Movie) *pb.Movie {
+	genres := genreNamesOf(m.Genres)
+	return &pb.Movie{
+		Id:               m.ID,
+		Title:            m.Title,
+		Genre:            strings.Join(genres, ", "),
+		Genres:           genres,
+		ReleaseYear:      m.ReleaseYear,
+		RuntimeMinutes:   m.RuntimeMinutes,
+		Synopsis:         m.Synopsis,
+		OriginalLanguage: m.OriginalLanguage,
+		AgeRating:        pb.AgeRating(pb.AgeRating_value[m.AgeRating]),
+		PosterUrl:        m.PosterURL,
+		AverageRating:    m.AverageRating,
+		ReviewCount:      m.ReviewCount,
+		Version:          m.Version,
+		CreatedAt:        timestampOrNil(m.CreatedAt),
+		UpdatedAt:        timestampOrNil(m.UpdatedAt),
+		DeletedAt:        timestampOrNil(m.DeletedAt.Time),
+	}
+}
+
+// movieFromProto maps the writable fields of movie. An unspecified age rating
+// is stored as an empty string so that struct updates leave it untouched.
+func movieFromProto(movie *pb.Movie) *models.Movie {
+	m := &models.Movie{
+		ID:               movie.GetId(),
+		Title:            movie.GetTitle(),
+		ReleaseYear:      movie.GetReleaseYear(),
+		RuntimeMinutes:   movie.GetRuntimeMinutes(),
+		Synopsis:         movie.GetSynopsis(),
+		OriginalLanguage: movie.GetOriginalLanguage(),
+		PosterURL:        movie.GetPosterUrl(),
+	}
+	if movie.GetAgeRating() != pb.AgeRating_AGE_RATING_UNSPECIFIED {
+		m.AgeRating = movie.GetAgeRating().String()
+	}
+	return m
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
 }
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// movieOrderColumns is the allow-list of order_by keys GetMovies accepts,
+// mapped to the column each one sorts on. Nothing outside it ever reaches
+// the ORDER BY clause.
+var movieOrderColumns = map[string]string{
+	"title":           "title",
+	"release_year":    "release_year",
+	"runtime_minutes": "runtime_minutes",
+	"created_at":      "created_at",
+	"updated_at":      "updated_at",
+	"rating":          "average_rating",
+}
+
+type MovieOrder struct {
+	Key    string
+	Column string
+	Desc   bool
+}
+
+// defaultMovieOrder lists movies oldest first. Keyset pagination needs a
+// stable order, so listings always have one.
+const defaultMovieOrder = "created_at"
+
+// ParseMovieOrder validates an order_by value such as "rating desc". An empty
+// value yields the default order.
+func ParseMovieOrder(orderBy string) (MovieOrder, error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+	if len(fields) == 0 {
+		fields = []string{defaultMovieOrder}
+	}
+	if len(fields) > 2 {
+		return MovieOrder{}, newFieldError("order_by", fmt.Sprintf("invalid order_by %q", orderBy))
+	}
+
+	column, ok := movieOrderColumns[fields[0]]
+	if !ok {
+		return MovieOrder{}, newFieldError("order_by", fmt.Sprintf("unsupported order_by column %q", fields[0]))
+	}
+
+	order := MovieOrder{Key: fields[0], Column: column}
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return MovieOrder{}, newFieldError("order_by", fmt.Sprintf("invalid order_by direction %q", fields[1]))
+		}
+	}
+	return order, nil
+}
+
+// String returns the canonical form of the order.
+func (o MovieOrder) String() string {
+	if o.Desc {
+		return o.Key + " desc"
+	}
+	return o.Key + " asc"
+}
+
+// Clause returns the ORDER BY clause for the order. Ties are broken by id so
+// that pages stay stable.
+func (o MovieOrder) Clause() string {
+	if o.Desc {
+		return o.Column + " DESC, id"
+	}
+	return o.Column + ", id"
+}
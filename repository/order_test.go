@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseMovieOrder(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		want    MovieOrder
+		wantErr bool
+	}{
+		{orderBy: "", want: MovieOrder{Key: "created_at", Column: "created_at"}},
+		{orderBy: "title", want: MovieOrder{Key: "title", Column: "title"}},
+		{orderBy: "  RATING   Desc ", want: MovieOrder{Key: "rating", Column: "average_rating", Desc: true}},
+		{orderBy: "release_year asc", want: MovieOrder{Key: "release_year", Column: "release_year"}},
+		{orderBy: "average_rating", wantErr: true},
+		{orderBy: "title sideways", wantErr: true},
+		{orderBy: "title desc id", wantErr: true},
+		{orderBy: "title; DROP TABLE movies", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseMovieOrder(tt.orderBy)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalid) {
+				t.Errorf("ParseMovieOrder(%q) error = %v, want ErrInvalid", tt.orderBy, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMovieOrder(%q) = %+v, %v, want %+v", tt.orderBy, got, err, tt.want)
+		}
+	}
+}
+
+func TestMovieOrderStringRoundTrips(t *testing.T) {
+	for _, orderBy := range []string{"", "title", "TITLE DESC", "rating desc", "updated_at asc"} {
+		order, err := ParseMovieOrder(orderBy)
+		if err != nil {
+			t.Fatalf("ParseMovieOrder(%q): %v", orderBy, err)
+		}
+		again, err := ParseMovieOrder(order.String())
+		if err != nil || again != order {
+			t.Errorf("ParseMovieOrder(%q) = %+v, %v, want %+v", order.String(), again, err, order)
+		}
+	}
+}
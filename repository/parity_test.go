@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The parity tests run the same calls against the in-memory repository and a
+// fresh SQLite database and expect the same answers, so tests and local runs
+// on the memory backend say something about production.
+
+// openTestDB returns a migrated SQLite database in a temporary directory.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "movies.db")), &gorm.Config{
+		NowFunc: Now,
+		Logger:  logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.AutoMigrate(&models.Movie{}, &models.Genre{}, &models.Person{}, &models.Credit{}, &models.Review{}, &models.MovieEvent{}, &models.IdempotencyKey{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MigrateMovieSearch(db); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MigrateMovieTitles(db); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+// movieRepositories returns an empty repository of each kind, by name.
+func movieRepositories(t *testing.T) map[string]MovieRepository {
+	t.Helper()
+
+	return map[string]MovieRepository{
+		"memory": NewMemoryMovieRepository(NewMemoryDB()),
+		"sqlite": NewMovieRepository(openTestDB(t)),
+	}
+}
+
+// seedMovies imports a small catalog under fixed ids, in order, so both
+// repositories break ties on the same ids.
+func seedMovies(t *testing.T, repo MovieRepository) {
+	t.Helper()
+
+	movies := []*pb.Movie{
+		{Id: "m1", Title: "The Matrix", ReleaseYear: 1999, RuntimeMinutes: 136, Genres: []string{"Sci-Fi", "Action"}},
+		{Id: "m2", Title: "Heat", ReleaseYear: 1995, RuntimeMinutes: 170, Genres: []string{"Crime"}},
+		{Id: "m3", Title: "The Matrix Reloaded", ReleaseYear: 2003, RuntimeMinutes: 138, Genres: []string{"science fiction"}},
+		{Id: "m4", Title: "Ronin", ReleaseYear: 1998, RuntimeMinutes: 122, Genres: []string{"Crime", "Action"}},
+		{Id: "m5", Title: "Arrival", ReleaseYear: 2016, RuntimeMinutes: 116, Genres: []string{"Sci-Fi"}},
+		{Id: "m6", Title: "Casino", ReleaseYear: 1995, RuntimeMinutes: 178, Genres: []string{"Crime"}},
+	}
+	results, err := repo.ImportMovies(context.Background(), movies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range results {
+		if result.Err != nil || !result.Created {
+			t.Fatalf("importing %s: created %v, %v", movies[i].Id, result.Created, result.Err)
+		}
+	}
+}
+
+// listing is the part of a page both repositories must agree on. Tokens
+// themselves may differ, as they carry timestamps.
+type listing struct {
+	ids   []string
+	total int64
+	more  bool
+}
+
+func listMovies(t *testing.T, repo MovieRepository, params dto.MovieListParams) listing {
+	t.Helper()
+
+	result, err := repo.GetMovies(context.Background(), params)
+	if err != nil {
+		t.Fatalf("GetMovies(%+v): %v", params, err)
+	}
+	page := listing{total: result.TotalRecords, more: result.NextPageToken != ""}
+	for _, m := range result.Movies {
+		page.ids = append(page.ids, m.GetId())
+	}
+	return page
+}
+
+// walkMovies follows page tokens to the end and returns every id in order.
+func walkMovies(t *testing.T, repo MovieRepository, params dto.MovieListParams) []string {
+	t.Helper()
+
+	var ids []string
+	for pages := 0; ; pages++ {
+		if pages > 10 {
+			t.Fatalf("GetMovies(%+v) did not run out of pages", params)
+		}
+		result, err := repo.GetMovies(context.Background(), params)
+		if err != nil {
+			t.Fatalf("GetMovies(%+v): %v", params, err)
+		}
+		for _, m := range result.Movies {
+			ids = append(ids, m.GetId())
+		}
+		if result.NextPageToken == "" {
+			return ids
+		}
+		params.PageToken = result.NextPageToken
+	}
+}
+
+func TestGetMoviesParity(t *testing.T) {
+	minRating := 0.0
+	tests := []struct {
+		name   string
+		params dto.MovieListParams
+		want   listing
+	}{
+		{
+			name:   "default order",
+			params: dto.MovieListParams{PageSize: 4, IncludeTotal: true},
+			want:   listing{ids: []string{"m1", "m2", "m3", "m4"}, total: 6, more: true},
+		},
+		{
+			name:   "second offset page",
+			params: dto.MovieListParams{Page: 2, PageSize: 4, IncludeTotal: true},
+			want:   listing{ids: []string{"m5", "m6"}, total: 6},
+		},
+		{
+			name:   "title descending",
+			params: dto.MovieListParams{OrderBy: "title desc"},
+			want:   listing{ids: []string{"m3", "m1", "m4", "m2", "m6", "m5"}},
+		},
+		{
+			name:   "ties broken by id",
+			params: dto.MovieListParams{OrderBy: "release_year", PageSize: 2},
+			want:   listing{ids: []string{"m2", "m6"}, more: true},
+		},
+		{
+			name:   "genre alias",
+			params: dto.MovieListParams{Genre: "science-fiction", IncludeTotal: true},
+			want:   listing{ids: []string{"m1", "m3", "m5"}, total: 3},
+		},
+		{
+			name:   "search title and genre names",
+			params: dto.MovieListParams{Search: "matrix", OrderBy: "release_year desc"},
+			want:   listing{ids: []string{"m3", "m1"}},
+		},
+		{
+			name:   "release years",
+			params: dto.MovieListParams{MinReleaseYear: 1996, MaxReleaseYear: 2003, MinRating: &minRating},
+			want:   listing{ids: []string{"m1", "m3", "m4"}},
+		},
+	}
+
+	for name, repo := range movieRepositories(t) {
+		seedMovies(t, repo)
+		for _, tt := range tests {
+			got := listMovies(t, repo, tt.params)
+			if !slices.Equal(got.ids, tt.want.ids) || got.total != tt.want.total || got.more != tt.want.more {
+				t.Errorf("%s: %s: got %+v, want %+v", name, tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestGetMoviesKeysetParity(t *testing.T) {
+	orders := map[string][]string{
+		"release_year":      {"m2", "m6", "m4", "m1", "m3", "m5"},
+		"release_year desc": {"m5", "m3", "m1", "m4", "m2", "m6"},
+		"runtime_minutes":   {"m5", "m4", "m1", "m3", "m2", "m6"},
+		"created_at desc":   {"m6", "m5", "m4", "m3", "m2", "m1"},
+	}
+	for name, repo := range movieRepositories(t) {
+		seedMovies(t, repo)
+		for orderBy, want := range orders {
+			for _, pageSize := range []int{1, 2, 4} {
+				got := walkMovies(t, repo, dto.MovieListParams{OrderBy: orderBy, PageSize: pageSize})
+				if !slices.Equal(got, want) {
+					t.Errorf("%s: %s in pages of %d = %q, want %q", name, orderBy, pageSize, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestUpdateMovieParity(t *testing.T) {
+	ctx := context.Background()
+	mask := func(paths ...string) MovieUpdateMask {
+		m, err := ParseMovieUpdateMask(paths)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	for name, repo := range movieRepositories(t) {
+		seedMovies(t, repo)
+
+		// A zero mask writes only the fields that are set.
+		got, err := repo.UpdateMovie(ctx, &pb.Movie{Id: "m2", Synopsis: "A heist goes wrong."}, MovieUpdateMask{}, 0)
+		if err != nil {
+			t.Fatalf("%s: update: %v", name, err)
+		}
+		if got.GetTitle() != "Heat" || got.GetSynopsis() != "A heist goes wrong." || got.GetRuntimeMinutes() != 170 || got.GetVersion() != 2 {
+			t.Errorf("%s: after zero-mask update got %v", name, got)
+		}
+
+		// An explicit mask clears what it lists and keeps the rest.
+		got, err = repo.UpdateMovie(ctx, &pb.Movie{Id: "m2", Genres: []string{"Thriller"}}, mask("synopsis", "genres"), 2)
+		if err != nil {
+			t.Fatalf("%s: masked update: %v", name, err)
+		}
+		if got.GetSynopsis() != "" || got.GetRuntimeMinutes() != 170 || !slices.Equal(got.GetGenres(), []string{"Thriller"}) || got.GetVersion() != 3 {
+			t.Errorf("%s: after masked update got %v", name, got)
+		}
+
+		failures := []struct {
+			name            string
+			movie           *pb.Movie
+			mask            MovieUpdateMask
+			expectedVersion int64
+			kind            error
+		}{
+			{"stale version", &pb.Movie{Id: "m2", Synopsis: "x"}, MovieUpdateMask{}, 2, ErrFailedPrecondition},
+			{"unknown movie", &pb.Movie{Id: "missing", Synopsis: "x"}, MovieUpdateMask{}, 0, ErrNotFound},
+			{"cleared title", &pb.Movie{Id: "m2"}, mask("title"), 0, ErrInvalid},
+			{"duplicate title", &pb.Movie{Id: "m6", Title: "heat!"}, MovieUpdateMask{}, 0, ErrAlreadyExists},
+		}
+		for _, f := range failures {
+			_, err := repo.UpdateMovie(ctx, f.movie, f.mask, f.expectedVersion)
+			if !errors.Is(err, f.kind) {
+				t.Errorf("%s: %s: error = %v, want %v", name, f.name, err, f.kind)
+			}
+		}
+
+		// Failed updates leave the movies as they were.
+		for id, version := range map[string]int64{"m2": 3, "m6": 1} {
+			movie, err := repo.GetMovie(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if movie.GetVersion() != version || movie.GetTitle() == "" {
+				t.Errorf("%s: %s after failed updates = %v", name, id, movie)
+			}
+		}
+	}
+}
+
+func TestCreatedAfterParity(t *testing.T) {
+	ctx := context.Background()
+	for name, repo := range movieRepositories(t) {
+		seedMovies(t, repo)
+		m3, err := repo.GetMovie(ctx, "m3")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Compare in another zone: the repositories must not care which one
+		// a caller uses.
+		after := m3.GetCreatedAt().AsTime().In(time.FixedZone("UTC+7", 7*60*60))
+		got := walkMovies(t, repo, dto.MovieListParams{CreatedAfter: after, OrderBy: "created_at", PageSize: 2})
+		if want := []string{"m4", "m5", "m6"}; !slices.Equal(got, want) {
+			t.Errorf("%s: created after m3 = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var ErrPersonNotFound = newResourceError(ErrNotFound, "person", "person not found")
+
+type PersonRepository interface {
+	CreatePerson(ctx context.Context, person *pb.Person) error
+	GetPerson(ctx context.Context, id string) (*pb.Person, error)
+	GetPeople(ctx context.Context, page, pageSize int, search string) ([]*pb.Person, int64, error)
+	UpdatePerson(ctx context.Context, person *pb.Person) (*pb.Person, error)
+	DeletePerson(ctx context.Context, id string) error
+}
+
+type personRepository struct {
+	db *gorm.DB
+}
+
+func NewPersonRepository(db *gorm.DB) PersonRepository {
+	return &personRepository{
+		db: db,
+	}
+}
+
+func (r *personRepository) CreatePerson(ctx context.Context, person *pb.Person) error {
+	person.Id = uuid.New().String()
+
+	data := personFromProto(person)
+	res := r.db.WithContext(ctx).Create(data)
+	if res.Error != nil {
+		return conflictError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("person creation unsuccessful")
+	}
+	person.CreatedAt = timestamppb.New(data.CreatedAt)
+	person.UpdatedAt = timestamppb.New(data.UpdatedAt)
+	return nil
+}
+
+func (r *personRepository) GetPerson(ctx context.Context, id string) (*pb.Person, error) {
+	var person models.Person
+	res := r.db.WithContext(ctx).Find(&person, "id = ?", id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, ErrPersonNotFound
+	}
+	return personToProto(&person), nil
+}
+
+func (r *personRepository) GetPeople(ctx context.Context, page, pageSize int, search string) ([]*pb.Person, int64, error) {
+	var (
+		people       []*models.Person
+		totalRecords int64
+	)
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.Person{})
+	if search != "" {
+		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(search)+"%")
+	}
+
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count people: %w", err)
+	}
+	if err := query.Order("name").Limit(pageSize).Offset((page - 1) * pageSize).Find(&people).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch people: %w", err)
+	}
+
+	pbPeople := make([]*pb.Person, len(people))
+	for i, p := range people {
+		pbPeople[i] = personToProto(p)
+	}
+	return pbPeople, totalRecords, nil
+}
+
+func (r *personRepository) UpdatePerson(ctx context.Context, person *pb.Person) (*pb.Person, error) {
+	changes := personFromProto(person)
+	changes.ID = ""
+
+	res := r.db.WithContext(ctx).Model(&models.Person{}).Where("id = ?", person.GetId()).Updates(changes)
+	if res.Error != nil {
+		return nil, conflictError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return nil, ErrPersonNotFound
+	}
+	return r.GetPerson(ctx, person.GetId())
+}
+
+// DeletePerson also removes every credit of the person.
+func (r *personRepository) DeletePerson(ctx context.Context, id string) error {
+	return transaction(ctx, r.db, func(tx *gorm.DB) error {
+		res := tx.Where("id = ?", id).Delete(&models.Person{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrPersonNotFound
+		}
+		return tx.Where("person_id = ?", id).Delete(&models.Credit{}).Error
+	})
+}
+
+func personToProto(p *models.Person) *pb.Person {
+	return &pb.Person{
+		Id:        p.ID,
+		Name:      p.Name,
+		Biography: p.Biography,
+		CreatedAt: timestampOrNil(p.CreatedAt),
+		UpdatedAt: timestampOrNil(p.UpdatedAt),
+	}
+}
+
+func personFromProto(person *pb.Person) *models.Person {
+	return &models.Person{
+		ID:        person.GetId(),
+		Name:      person.GetName(),
+		Biography: person.GetBiography(),
+	}
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var ErrReviewNotFound = newResourceError(ErrNotFound, "review", "review not found")
+
+const (
+	minReviewScore = 1
+	maxReviewScore = 10
+)
+
+// ReviewRepository stores reviews. Every write also refreshes the average
+// rating and review count on the reviewed movie in the same transaction.
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review *pb.Review) error
+	UpdateReview(ctx context.Context, review *pb.Review) (*pb.Review, error)
+	DeleteReview(ctx context.Context, id string) (*pb.Review, error)
+	GetReviews(ctx context.Context, movieID string, page, pageSize int) ([]*pb.Review, int64, error)
+}
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return &reviewRepository{
+		db: db,
+	}
+}
+
+func (r *reviewRepository) CreateReview(ctx context.Context, review *pb.Review) error {
+	if err := validateReviewScore(review.GetScore()); err != nil {
+		return err
+	}
+
+	review.Id = uuid.New().String()
+	data := reviewFromProto(review)
+
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		var movie models.Movie
+		if res := tx.Select("id").Find(&movie, "id = ?", data.MovieID); res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return ErrMovieNotFound
+		}
+
+		res := tx.Create(data)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errors.New("review creation unsuccessful")
+		}
+		return refreshMovieRating(tx, data.MovieID)
+	})
+	if err != nil {
+		return err
+	}
+
+	review.CreatedAt = timestamppb.New(data.CreatedAt)
+	review.UpdatedAt = timestamppb.New(data.UpdatedAt)
+	return nil
+}
+
+// UpdateReview changes the score and text of a review. The movie and author
+// a review belongs to cannot be changed.
+func (r *reviewRepository) UpdateReview(ctx context.Context, review *pb.Review) (*pb.Review, error) {
+	if err := validateReviewScore(review.GetScore()); err != nil {
+		return nil, err
+	}
+
+	var updated models.Review
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		res := tx.Model(&models.Review{}).
+			Where("id = ?", review.GetId()).
+			Updates(map[string]interface{}{"score": review.GetScore(), "text": review.GetText()})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrReviewNotFound
+		}
+
+		if err := tx.First(&updated, "id = ?", review.GetId()).Error; err != nil {
+			return err
+		}
+		return refreshMovieRating(tx, updated.MovieID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reviewToProto(&updated), nil
+}
+
+// DeleteReview returns the deleted review so callers know which movie's
+// rating changed.
+func (r *reviewRepository) DeleteReview(ctx context.Context, id string) (*pb.Review, error) {
+	var review models.Review
+	err := transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if res := tx.Find(&review, "id = ?", id); res.Error != nil {
+			return res.Error
+		} else if res.RowsAffected == 0 {
+			return ErrReviewNotFound
+		}
+		if err := tx.Delete(&review).Error; err != nil {
+			return err
+		}
+		return refreshMovieRating(tx, review.MovieID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reviewToProto(&review), nil
+}
+
+// GetReviews lists the reviews of a movie, newest first.
+func (r *reviewRepository) GetReviews(ctx context.Context, movieID string, page, pageSize int) ([]*pb.Review, int64, error) {
+	var (
+		reviews      []*models.Review
+		totalRecords int64
+	)
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.Review{}).Where("movie_id = ?", movieID)
+	if err := query.Count(&totalRecords).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count reviews: %w", err)
+	}
+	if err := query.Order("created_at DESC, id").Limit(pageSize).Offset((page - 1) * pageSize).Find(&reviews).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch reviews: %w", err)
+	}
+
+	pbReviews := make([]*pb.Review, len(reviews))
+	for i, rv := range reviews {
+		pbReviews[i] = reviewToProto(rv)
+	}
+	return pbReviews, totalRecords, nil
+}
+
+// refreshMovieRating recomputes the aggregate rating columns of a movie from
+// its reviews. It deliberately leaves updated_at alone: a new review is not an
+// edit of the movie.
+func refreshMovieRating(tx *gorm.DB, movieID string) error {
+	err := tx.Exec(`UPDATE movies SET
+		average_rating = (SELECT COALESCE(AVG(score), 0) FROM reviews WHERE movie_id = ?),
+		review_count = (SELECT COUNT(*) FROM reviews WHERE movie_id = ?)
+		WHERE id = ?`, movieID, movieID, movieID).Error
+	if err != nil {
+		return err
+	}
+	return recordMovieEvents(tx, pb.MovieEventType_MOVIE_EVENT_TYPE_UPDATED, []string{movieID})
+}
+
+func validateReviewScore(score int32) error {
+	if score < minReviewScore || score > maxReviewScore {
+		return newFieldError("review.score", fmt.Sprintf("review score must be between %d and %d", minReviewScore, maxReviewScore))
+	}
+	return nil
+}
+
+func reviewToProto(rv *models.Review) *pb.Review {
+	return &pb.Review{
+		Id:        rv.ID,
+		MovieId:   rv.MovieID,
+		Author:    rv.Author,
+		Score:     rv.Score,
+		Text:      rv.Text,
+		CreatedAt: timestampOrNil(rv.CreatedAt),
+		UpdatedAt: timestampOrNil(rv.UpdatedAt),
+	}
+}
+
+func reviewFromProto(review *pb.Review) *models.Review {
+	return &models.Review{
+		ID:      review.GetId(),
+		MovieID: review.GetMovieId(),
+		Author:  review.GetAuthor(),
+		Score:   review.GetScore(),
+		Text:    review.GetText(),
+	}
+}
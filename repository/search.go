@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidSearchQuery = newFieldError("query", "search query has no searchable terms")
+
+const (
+	highlightStart = "<mark>"
+	highlightEnd   = "</mark>"
+	snippetWords   = 12
+)
+
+// Relevance weights of the title, genres and synopsis columns. The in-process
+// ranking uses the same weights so both paths order results alike.
+const (
+	titleWeight    = 10.0
+	genresWeight   = 5.0
+	synopsisWeight = 1.0
+)
+
+// MigrateMovieSearch creates the movies_fts full-text index and fills it the
+// first time. It returns false without error when SQLite was built without
+// FTS5 (the sqlite_fts5 build tag), in which case search keeps using LIKE.
+func MigrateMovieSearch(db *gorm.DB) (bool, error) {
+	if !fts5Enabled(db) {
+		return false, nil
+	}
+	if db.Migrator().HasTable("movies_fts") {
+		return true, nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`CREATE VIRTUAL TABLE movies_fts USING fts5(
+			movie_id UNINDEXED, title, genres, synopsis,
+			tokenize = 'unicode61 remove_diacritics 2'
+		)`).Error
+		if err != nil {
+			return fmt.Errorf("failed to create movie search index: %w", err)
+		}
+		if err := tx.Exec(movieSearchInsert + " FROM movies m WHERE m.deleted_at IS NULL").Error; err != nil {
+			return fmt.Errorf("failed to fill movie search index: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// hasMovieSearch reports whether the movies_fts index exists and can be
+// queried by this build.
+func hasMovieSearch(db *gorm.DB) bool {
+	return fts5Enabled(db) && db.Migrator().HasTable("movies_fts")
+}
+
+func fts5Enabled(db *gorm.DB) bool {
+	var enabled bool
+	err := db.Raw("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled).Error
+	return err == nil && enabled
+}
+
+const movieSearchInsert = `INSERT INTO movies_fts (movie_id, title, genres, synopsis)
+	SELECT m.id, m.title, COALESCE((
+		SELECT group_concat(g.name, ' ') FROM movie_genres mg
+		JOIN genres g ON g.id = mg.genre_id
+		WHERE mg.movie_id = m.id
+	), ''), m.synopsis`
+
+// syncMovieSearch rewrites the index rows of the given movies from their
+// current state. Movies that no longer exist or are deleted simply lose their
+// rows. ids is a slice of IDs or a subquery selecting them.
+func syncMovieSearch(tx *gorm.DB, ids interface{}) error {
+	if err := tx.Exec("DELETE FROM movies_fts WHERE movie_id IN (?)", ids).Error; err != nil {
+		return fmt.Errorf("failed to update movie search index: %w", err)
+	}
+	if err := tx.Exec(movieSearchInsert+" FROM movies m WHERE m.id IN (?) AND m.deleted_at IS NULL", ids).Error; err != nil {
+		return fmt.Errorf("failed to update movie search index: %w", err)
+	}
+	return nil
+}
+
+// searchTerms splits a user query into lower-cased words. Everything that is
+// not a letter or digit separates words, so FTS5 operators in user input are
+// never interpreted.
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// ftsMatch builds an FTS5 MATCH expression requiring every term as a word
+// prefix, so partially typed queries still match.
+func ftsMatch(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + term + `"*`
+	}
+	return strings.Join(quoted, " ")
+}
+
+// rankMovies is the in-process counterpart of an FTS5 query: it keeps the
+// movies in which every term prefixes some word, scores them with the column
+// weights and returns them best first.
+func rankMovies(movies []*models.Movie, terms []string) []*pb.MovieSearchResult {
+	var results []*pb.MovieSearchResult
+	for _, m := range movies {
+		genres := strings.Join(genreNamesOf(m.Genres), " ")
+
+		var score float64
+		matched := true
+		for _, term := range terms {
+			title := countPrefixMatches(m.Title, term)
+			genre := countPrefixMatches(genres, term)
+			synopsis := countPrefixMatches(m.Synopsis, term)
+			if title+genre+synopsis == 0 {
+				matched = false
+				break
+			}
+			score += titleWeight*float64(title) + genresWeight*float64(genre) + synopsisWeight*float64(synopsis)
+		}
+		if !matched {
+			continue
+		}
+
+		results = append(results, &pb.MovieSearchResult{
+			Movie:          movieToProto(m),
+			Score:          score,
+			TitleHighlight: highlightTerms(m.Title, terms),
+			Snippet:        snippetOf(m.Synopsis, terms),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Movie.GetTitle() < results[j].Movie.GetTitle()
+	})
+	return results
+}
+
+type wordSpan struct {
+	start, end int
+}
+
+func wordSpans(text string) []wordSpan {
+	var (
+		spans []wordSpan
+		start = -1
+	)
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			spans = append(spans, wordSpan{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, wordSpan{start, len(text)})
+	}
+	return spans
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func countPrefixMatches(text, term string) int {
+	n := 0
+	for _, span := range wordSpans(text) {
+		if strings.HasPrefix(strings.ToLower(text[span.start:span.end]), term) {
+			n++
+		}
+	}
+	return n
+}
+
+// highlightTerms wraps every word of text matching a term in the highlight
+// markers, like the FTS5 highlight() function.
+func highlightTerms(text string, terms []string) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range wordSpans(text) {
+		word := text[span.start:span.end]
+		if !matchesAnyTerm(word, terms) {
+			continue
+		}
+		b.WriteString(text[last:span.start])
+		b.WriteString(highlightStart + word + highlightEnd)
+		last = span.end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// snippetOf returns a highlighted window of about snippetWords words around
+// the first match in text, like the FTS5 snippet() function.
+func snippetOf(text string, terms []string) string {
+	spans := wordSpans(text)
+	if len(spans) == 0 {
+		return ""
+	}
+
+	first := 0
+	for i, span := range spans {
+		if matchesAnyTerm(text[span.start:span.end], terms) {
+			first = i
+			break
+		}
+	}
+	from := max(0, min(first-snippetWords/4, len(spans)-snippetWords))
+	to := min(from+snippetWords, len(spans))
+
+	snippet := highlightTerms(text[spans[from].start:spans[to-1].end], terms)
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(spans) {
+		snippet += "…"
+	}
+	return snippet
+}
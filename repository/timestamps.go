@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SQLite keeps timestamps as text with their UTC offset and compares them as
+// text, so times only order correctly against times in the same zone. Every
+// timestamp is therefore written and compared in UTC: Now is the clock GORM
+// stamps rows with, and dbTime converts a time before it is bound to a query.
+// Were the process zone used instead, changing it would break every
+// comparison against existing rows.
+
+// Now is the current time as the database stores it.
+func Now() time.Time {
+	return time.Now().UTC()
+}
+
+func dbTime(t time.Time) time.Time {
+	return t.UTC()
+}
+
+// timestampColumns are the columns holding times, by table.
+var timestampColumns = map[string][]string{
+	"movies":           {"created_at", "updated_at", "deleted_at"},
+	"genres":           {"created_at", "updated_at"},
+	"people":           {"created_at", "updated_at"},
+	"credits":          {"created_at", "updated_at"},
+	"reviews":          {"created_at", "updated_at"},
+	"movie_events":     {"created_at"},
+	"idempotency_keys": {"created_at"},
+}
+
+// MigrateTimestampsToUTC rewrites timestamps stored in another zone, as they
+// were before Now, in UTC. SQLite's date functions keep milliseconds, which
+// is all the precision the rewritten rows keep. Rows already in UTC are left
+// alone, so it is safe to run on every start.
+func MigrateTimestampsToUTC(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for table, columns := range timestampColumns {
+			for _, column := range columns {
+				err := tx.Exec(fmt.Sprintf(
+					`UPDATE %[1]s SET %[2]s = strftime('%%Y-%%m-%%d %%H:%%M:%%f+00:00', %[2]s)
+					WHERE %[2]s IS NOT NULL AND %[2]s NOT LIKE '%%+00:00'`,
+					table, column,
+				)).Error
+				if err != nil {
+					return fmt.Errorf("failed to convert %s.%s to UTC: %w", table, column, err)
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+)
+
+// movieUpdateColumns is the allow-list of update_mask paths UpdateMovie
+// accepts, mapped to the column each one writes. genres and its legacy form
+// genre replace the genre association instead of a column.
+var movieUpdateColumns = map[string]string{
+	"title":             "title",
+	"release_year":      "release_year",
+	"runtime_minutes":   "runtime_minutes",
+	"synopsis":          "synopsis",
+	"original_language": "original_language",
+	"age_rating":        "age_rating",
+	"poster_url":        "poster_url",
+}
+
+// movieRequiredColumns are the columns no movie may be without, mapped to the
+// field reported when an update would clear one.
+var movieRequiredColumns = map[string]string{
+	"title": "movie.title",
+}
+
+// movieReadOnlyPaths are fields of a movie that exist but are never written
+// by clients.
+var movieReadOnlyPaths = map[string]bool{
+	"id":             true,
+	"created_at":     true,
+	"updated_at":     true,
+	"average_rating": true,
+	"review_count":   true,
+}
+
+// MovieUpdateMask is the parsed form of an update_mask. The zero value writes
+// every non-empty field, the behaviour from before masks existed.
+type MovieUpdateMask struct {
+	Columns []string
+	Genres  bool
+}
+
+// ParseMovieUpdateMask validates update_mask paths. No paths yields the zero
+// mask.
+func ParseMovieUpdateMask(paths []string) (MovieUpdateMask, error) {
+	var mask MovieUpdateMask
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		switch {
+		case path == "genres" || path == "genre":
+			mask.Genres = true
+		case movieUpdateColumns[path] != "":
+			mask.Columns = append(mask.Columns, movieUpdateColumns[path])
+		case movieReadOnlyPaths[path]:
+			return MovieUpdateMask{}, newFieldError("update_mask", fmt.Sprintf("field %q cannot be updated", path))
+		default:
+			return MovieUpdateMask{}, newFieldError("update_mask", fmt.Sprintf("unknown update_mask path %q", path))
+		}
+	}
+	return mask, nil
+}
+
+// IsZero reports whether the mask lists no fields.
+func (m MovieUpdateMask) IsZero() bool {
+	return len(m.Columns) == 0 && !m.Genres
+}
+
+// nonZeroMovieUpdateMask is what a zero mask stands for: every column m holds
+// a non-zero value for, and the genres when there are any.
+func nonZeroMovieUpdateMask(m *models.Movie, genres []string) MovieUpdateMask {
+	columns := make([]string, 0, len(movieUpdateColumns))
+	for _, column := range movieUpdateColumns {
+		columns = append(columns, column)
+	}
+
+	mask := MovieUpdateMask{Genres: len(genres) > 0}
+	for column, value := range movieColumnValues(m, columns) {
+		if value != "" && value != int32(0) {
+			mask.Columns = append(mask.Columns, column)
+		}
+	}
+	return mask
+}
+
+// checkRequiredMovieColumns fails when mask would write an empty value to a
+// column every movie needs, as an explicit mask may for other columns.
+func checkRequiredMovieColumns(m *models.Movie, mask MovieUpdateMask) error {
+	for column, value := range movieColumnValues(m, mask.Columns) {
+		if field := movieRequiredColumns[column]; field != "" && value == "" {
+			return newFieldError(field, fmt.Sprintf("%s cannot be cleared", column))
+		}
+	}
+	return nil
+}
+
+// movieColumnValues returns the values m holds for columns, keyed by column.
+func movieColumnValues(m *models.Movie, columns []string) map[string]interface{} {
+	values := make(map[string]interface{}, len(columns)+1)
+	for _, column := range columns {
+		switch column {
+		case "title":
+			values[column] = m.Title
+		case "release_year":
+			values[column] = m.ReleaseYear
+		case "runtime_minutes":
+			values[column] = m.RuntimeMinutes
+		case "synopsis":
+			values[column] = m.Synopsis
+		case "original_language":
+			values[column] = m.OriginalLanguage
+		case "age_rating":
+			values[column] = m.AgeRating
+		case "poster_url":
+			values[column] = m.PosterURL
+		}
+	}
+	return values
+}
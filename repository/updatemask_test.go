@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/renaldyhidayatt/movie_grpc/models"
+)
+
+func TestParseMovieUpdateMask(t *testing.T) {
+	tests := []struct {
+		paths   []string
+		columns []string
+		genres  bool
+		wantErr bool
+	}{
+		{paths: nil},
+		{paths: []string{"title"}, columns: []string{"title"}},
+		{paths: []string{"synopsis", "poster_url", "synopsis"}, columns: []string{"synopsis", "poster_url"}},
+		{paths: []string{"genres"}, genres: true},
+		{paths: []string{"genre", "release_year"}, columns: []string{"release_year"}, genres: true},
+		{paths: []string{"id"}, wantErr: true},
+		{paths: []string{"average_rating"}, wantErr: true},
+		{paths: []string{"title", "director"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		mask, err := ParseMovieUpdateMask(tt.paths)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalid) {
+				t.Errorf("ParseMovieUpdateMask(%q) error = %v, want ErrInvalid", tt.paths, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseMovieUpdateMask(%q): %v", tt.paths, err)
+			continue
+		}
+		if !slices.Equal(mask.Columns, tt.columns) || mask.Genres != tt.genres {
+			t.Errorf("ParseMovieUpdateMask(%q) = %+v, want columns %q, genres %v", tt.paths, mask, tt.columns, tt.genres)
+		}
+		if mask.IsZero() != (len(tt.paths) == 0) {
+			t.Errorf("ParseMovieUpdateMask(%q).IsZero() = %v", tt.paths, mask.IsZero())
+		}
+	}
+}
+
+func TestNonZeroMovieUpdateMask(t *testing.T) {
+	m := &models.Movie{Title: "Heat", RuntimeMinutes: 170}
+	mask := nonZeroMovieUpdateMask(m, nil)
+	slices.Sort(mask.Columns)
+	if want := []string{"runtime_minutes", "title"}; !slices.Equal(mask.Columns, want) || mask.Genres {
+		t.Errorf("nonZeroMovieUpdateMask = %+v, want columns %q and no genres", mask, want)
+	}
+
+	if mask := nonZeroMovieUpdateMask(&models.Movie{}, []string{"Crime"}); len(mask.Columns) != 0 || !mask.Genres {
+		t.Errorf("nonZeroMovieUpdateMask with only genres = %+v", mask)
+	}
+}
+
+func TestCheckRequiredMovieColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		movie   models.Movie
+		paths   []string
+		wantErr bool
+	}{
+		{"clears optional field", models.Movie{}, []string{"synopsis", "poster_url"}, false},
+		{"sets title", models.Movie{Title: "Heat"}, []string{"title"}, false},
+		{"clears title", models.Movie{Synopsis: "kept"}, []string{"title", "synopsis"}, true},
+		{"leaves title alone", models.Movie{}, []string{"release_year"}, false},
+	}
+	for _, tt := range tests {
+		mask, err := ParseMovieUpdateMask(tt.paths)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = checkRequiredMovieColumns(&tt.movie, mask)
+		if tt.wantErr {
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != "movie.title" {
+				t.Errorf("%s: error = %v, want a movie.title FieldError", tt.name, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
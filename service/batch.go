@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"github.com/renaldyhidayatt/movie_grpc/repository"
+	"github.com/renaldyhidayatt/movie_grpc/validation"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MovieService) checkBatchSize(n int) error {
+	if n > s.batchLimit {
+		return status.Errorf(codes.InvalidArgument, "batch has %d items, the limit is %d", n, s.batchLimit)
+	}
+	return nil
+}
+
+// BatchGetMovies reads the movies with a single cache lookup and a single
+// query for the cache misses. An unknown ID fails only its own result.
+func (s *MovieService) BatchGetMovies(ctx context.Context, req *pb.BatchGetMoviesRequest) (*pb.BatchGetMoviesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"BatchGetMovies",
+		attribute.Int("batch.size", len(req.GetIds())),
+	)
+	defer func() { end(err) }()
+
+	ids := req.GetIds()
+	if err = s.checkBatchSize(len(ids)); err != nil {
+		return nil, err
+	}
+
+	movies, err := s.getMoviesByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.BatchGetMoviesResponse{
+		Results: make([]*pb.BatchMovieResult, len(ids)),
+	}
+	for i, id := range ids {
+		result := &pb.BatchMovieResult{Id: id}
+		if movie, ok := movies[id]; ok {
+			result.Movie = movie
+		} else {
+			result.Error = batchError(repository.ErrMovieNotFound, id)
+		}
+		res.Results[i] = result
+	}
+	return res, nil
+}
+
+// getMoviesByID is the batch form of getMovie: one cache read for every ID,
+// then one repository read for whatever the cache did not have.
+func (s *MovieService) getMoviesByID(ctx context.Context, ids []string) (map[string]*pb.Movie, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	movies, cacheErr := s.mencache.GetMoviesByID(ctx, ids)
+	if cacheErr != nil {
+		s.logger.Warn("failed to get movies from cache",
+			zap.Int("batch.size", len(ids)),
+			zap.Error(cacheErr),
+		)
+	}
+	if movies == nil {
+		movies = make(map[string]*pb.Movie, len(ids))
+	}
+
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := movies[id]; !ok && !seen[id] {
+			missing = append(missing, id)
+			seen[id] = true
+		}
+	}
+	if len(missing) == 0 {
+		return movies, nil
+	}
+
+	found, err := s.repo.GetMoviesByID(ctx, missing)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch movies: %v", err)
+	}
+	for id, movie := range found {
+		s.fillMovieCache(ctx, movie)
+		movies[id] = movie
+	}
+	return movies, nil
+}
+
+// BatchCreateMovies creates the movies in one transaction. Each movie gets a
+// fresh ID; a failing movie is reported in its result and the rest are kept.
+func (s *MovieService) BatchCreateMovies(ctx context.Context, req *pb.BatchCreateMoviesRequest) (*pb.BatchCreateMoviesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"BatchCreateMovies",
+		attribute.Int("batch.size", len(req.GetMovies())),
+	)
+	defer func() { end(err) }()
+
+	if err = s.checkBatchSize(len(req.GetMovies())); err != nil {
+		return nil, err
+	}
+
+	res := &pb.BatchCreateMoviesResponse{
+		Results: make([]*pb.BatchMovieResult, len(req.GetMovies())),
+	}
+	var (
+		movies  []*pb.Movie
+		indexes []int
+	)
+	for i, movie := range req.GetMovies() {
+		if err := validation.Validate(&pb.CreateMovieRequest{Movie: movie}); err != nil {
+			res.Results[i] = &pb.BatchMovieResult{
+				Error: batchError(err, ""),
+			}
+			continue
+		}
+		movies = append(movies, movie)
+		indexes = append(indexes, i)
+	}
+	if len(movies) == 0 {
+		return res, nil
+	}
+
+	errs, err := s.repo.CreateMovies(ctx, movies)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "failed to create movies: %v", err)
+		return nil, err
+	}
+
+	created := false
+	for i, movie := range movies {
+		result := &pb.BatchMovieResult{Id: movie.GetId()}
+		if errs[i] != nil {
+			result.Error = batchError(errs[i], "")
+		} else {
+			result.Movie = movie
+			s.cacheMovie(ctx, movie)
+			created = true
+		}
+		res.Results[indexes[i]] = result
+	}
+	if created {
+		s.invalidateMovieLists(ctx)
+	}
+	return res, nil
+}
+
+// BatchDeleteMovies deletes the movies in one transaction. An unknown ID
+// fails only its own result.
+func (s *MovieService) BatchDeleteMovies(ctx context.Context, req *pb.BatchDeleteMoviesRequest) (*pb.BatchDeleteMoviesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"BatchDeleteMovies",
+		attribute.Int("batch.size", len(req.GetIds())),
+	)
+	defer func() { end(err) }()
+
+	ids := req.GetIds()
+	if err = s.checkBatchSize(len(ids)); err != nil {
+		return nil, err
+	}
+
+	res := &pb.BatchDeleteMoviesResponse{
+		Results: make([]*pb.BatchMovieResult, len(ids)),
+	}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	errs, err := s.repo.DeleteMovies(ctx, ids)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "failed to delete movies: %v", err)
+		return nil, err
+	}
+
+	deleted := false
+	for i, id := range ids {
+		result := &pb.BatchMovieResult{Id: id}
+		if errs[i] != nil {
+			result.Error = batchError(errs[i], id)
+		} else {
+			s.evictMovie(ctx, id)
+			deleted = true
+		}
+		res.Results[i] = result
+	}
+	if deleted {
+		s.invalidateMovieLists(ctx)
+	}
+	return res, nil
+}
+
+// batchError turns the error of one batch item into the code and message a
+// single call would have failed with.
+func batchError(err error, id string) *pb.BatchError {
+	st := status.Convert(statusError(err, id))
+	return &pb.BatchError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+}
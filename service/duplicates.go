@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	defaultDuplicateSimilarity = 0.85
+	defaultDuplicateLimit      = 100
+)
+
+// FindDuplicates reports live movies that look like the same movie entered
+// more than once, such as those created before titles had to be unique. It
+// compares every pair of titles, so it is meant for occasional admin use.
+func (s *MovieService) FindDuplicates(ctx context.Context, req *pb.FindDuplicatesRequest) (*pb.FindDuplicatesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"FindDuplicates",
+		attribute.Float64("duplicates.min_similarity", req.GetMinSimilarity()),
+	)
+	defer func() { end(err) }()
+
+	minSimilarity := req.GetMinSimilarity()
+	if minSimilarity == 0 {
+		minSimilarity = defaultDuplicateSimilarity
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultDuplicateLimit
+	}
+
+	duplicates, err := s.repo.FindDuplicateMovies(ctx, minSimilarity, limit)
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	res := &pb.FindDuplicatesResponse{
+		Duplicates: make([]*pb.DuplicateMovies, len(duplicates)),
+	}
+	for i, d := range duplicates {
+		res.Duplicates[i] = &pb.DuplicateMovies{
+			Movie:      d.Movie,
+			Duplicate:  d.Duplicate,
+			Similarity: d.Similarity,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/renaldyhidayatt/movie_grpc/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// repositoryErrorCodes maps the kinds of repository error to the code a
+// client sees.
+var repositoryErrorCodes = []struct {
+	kind error
+	code codes.Code
+}{
+	{repository.ErrNotFound, codes.NotFound},
+	{repository.ErrAlreadyExists, codes.AlreadyExists},
+	{repository.ErrConflict, codes.Aborted},
+	{repository.ErrFailedPrecondition, codes.FailedPrecondition},
+	{repository.ErrInvalid, codes.InvalidArgument},
+}
+
+// statusError turns an error from a repository into the status returned to
+// the client. name identifies the resource the call was about, if any, and is
+// reported in the ResourceInfo detail. Status errors pass through unchanged,
+// and errors of no known kind become Internal.
+func statusError(err error, name string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	code := codes.Internal
+	for _, c := range repositoryErrorCodes {
+		if errors.Is(err, c.kind) {
+			code = c.code
+			break
+		}
+	}
+	st := status.New(code, err.Error())
+
+	var (
+		fieldErr    *repository.FieldError
+		resourceErr *repository.ResourceError
+	)
+	switch {
+	case errors.As(err, &fieldErr):
+		st = withDetails(st, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       fieldErr.Field,
+				Description: err.Error(),
+			}},
+		})
+	case errors.As(err, &resourceErr):
+		if resourceErr.Name != "" {
+			name = resourceErr.Name
+		}
+		st = withDetails(st, &errdetails.ResourceInfo{
+			ResourceType: resourceErr.Resource,
+			ResourceName: name,
+			Description:  err.Error(),
+		})
+	}
+	return st.Err()
+}
+
+// withDetails attaches detail to st, keeping st as it is if that fails.
+func withDetails(st *status.Status, detail protoadapt.MessageV1) *status.Status {
+	if withDetail, err := st.WithDetails(detail); err == nil {
+		return withDetail
+	}
+	return st
+}
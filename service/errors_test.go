@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/renaldyhidayatt/movie_grpc/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusErrorCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", repository.ErrMovieNotFound, codes.NotFound},
+		{"duplicate", fmt.Errorf("creating: %w", repository.ErrAlreadyExists), codes.AlreadyExists},
+		{"concurrent write", fmt.Errorf("%w: database is locked", repository.ErrConflict), codes.Aborted},
+		{"version mismatch", repository.ErrMovieVersionMismatch, codes.FailedPrecondition},
+		{"not in trash", repository.ErrMovieNotDeleted, codes.FailedPrecondition},
+		{"reused idempotency key", repository.ErrIdempotencyKeyReused, codes.InvalidArgument},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"status passes through", status.Error(codes.Unavailable, "down"), codes.Unavailable},
+		{"unknown", errors.New("disk on fire"), codes.Internal},
+	}
+	for _, tt := range tests {
+		if got := status.Code(statusError(tt.err, "m1")); got != tt.want {
+			t.Errorf("%s: code = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestStatusErrorDetails(t *testing.T) {
+	st := status.Convert(statusError(repository.ErrMovieVersionMismatch, "m1"))
+	var info *errdetails.ResourceInfo
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.ResourceInfo); ok {
+			info = d
+		}
+	}
+	if info == nil || info.GetResourceType() != "movie" || info.GetResourceName() != "m1" {
+		t.Errorf("ResourceInfo = %v, want movie m1", info)
+	}
+
+	st = status.Convert(statusError(repository.ErrIdempotencyKeyReused, ""))
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if len(badRequest.GetFieldViolations()) != 1 || badRequest.GetFieldViolations()[0].GetField() != "request_id" {
+		t.Errorf("BadRequest = %v, want a request_id violation", badRequest)
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+func (s *MovieService) CreateGenre(ctx context.Context, req *pb.CreateGenreRequest) (*pb.CreateGenreResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"CreateGenre",
+		attribute.String("genre.name", req.GetGenre().GetName()),
+	)
+	defer func() { end(err) }()
+
+	genre := req.GetGenre()
+	err = s.genreRepo.CreateGenre(ctx, genre)
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.CreateGenreResponse{
+		Genre: genre,
+	}, nil
+}
+
+func (s *MovieService) GetGenre(ctx context.Context, req *pb.ReadGenreRequest) (*pb.ReadGenreResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"GetGenre",
+		attribute.String("genre.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	genre, err := s.genreRepo.GetGenre(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	return &pb.ReadGenreResponse{
+		Genre: genre,
+	}, nil
+}
+
+func (s *MovieService) GetGenres(ctx context.Context, req *pb.ReadGenresRequest) (*pb.ReadGenresResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"GetGenres",
+	)
+	defer func() { end(err) }()
+
+	genres, err := s.genreRepo.GetGenres(ctx)
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.ReadGenresResponse{
+		Genres: genres,
+	}, nil
+}
+
+func (s *MovieService) UpdateGenre(ctx context.Context, req *pb.UpdateGenreRequest) (*pb.UpdateGenreResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"UpdateGenre",
+		attribute.String("genre.id", req.GetGenre().GetId()),
+	)
+	defer func() { end(err) }()
+
+	genre, err := s.genreRepo.UpdateGenre(ctx, req.GetGenre())
+	if err != nil {
+		return nil, statusError(err, req.GetGenre().GetId())
+	}
+
+	s.evictGenreMovies(ctx, genre.GetId())
+
+	return &pb.UpdateGenreResponse{
+		Genre: genre,
+	}, nil
+}
+
+func (s *MovieService) DeleteGenre(ctx context.Context, req *pb.DeleteGenreRequest) (*pb.DeleteGenreResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"DeleteGenre",
+		attribute.String("genre.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	// The movies have to be looked up before the genre and its links are gone.
+	movieIDs, lookupErr := s.genreRepo.GetGenreMovieIDs(ctx, req.GetId())
+	if lookupErr != nil {
+		s.logger.Warn("failed to look up movies of genre",
+			zap.String("genre.id", req.GetId()),
+			zap.Error(lookupErr),
+		)
+	}
+
+	err = s.genreRepo.DeleteGenre(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	for _, id := range movieIDs {
+		s.evictMovie(ctx, id)
+	}
+	s.invalidateMovieLists(ctx)
+
+	return &pb.DeleteGenreResponse{
+		Success: true,
+	}, nil
+}
+
+// evictGenreMovies drops every cached movie carrying the genre, since each of
+// them embeds the genre's name.
+func (s *MovieService) evictGenreMovies(ctx context.Context, genreID string) {
+	movieIDs, err := s.genreRepo.GetGenreMovieIDs(ctx, genreID)
+	if err != nil {
+		s.logger.Warn("failed to look up movies of genre",
+			zap.String("genre.id", genreID),
+			zap.Error(err),
+		)
+	}
+	for _, id := range movieIDs {
+		s.evictMovie(ctx, id)
+	}
+	s.invalidateMovieLists(ctx)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/renaldyhidayatt/movie_grpc/dto"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// idempotencyKeyHeader carries the idempotency key of clients that do
+	// not set request_id.
+	idempotencyKeyHeader = "idempotency-key"
+	// idempotentReplayedHeader is sent back, set to "true", when a response
+	// is the replay of an earlier request with the same key.
+	idempotentReplayedHeader = "idempotent-replayed"
+	maxIdempotencyKeyLength  = 128
+)
+
+// idempotencyKey returns the key of a CreateMovie request, taken from
+// request_id or else the idempotency-key header, or nil when there is none.
+func (s *MovieService) idempotencyKey(ctx context.Context, req *pb.CreateMovieRequest) (*dto.IdempotencyKey, error) {
+	if s.idempotencyWindow <= 0 {
+		return nil, nil
+	}
+
+	key := req.GetRequestId()
+	if key == "" {
+		if values := metadata.ValueFromIncomingContext(ctx, idempotencyKeyHeader); len(values) > 0 {
+			key = values[0]
+		}
+	}
+	if key == "" {
+		return nil, nil
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+
+	request, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.GetMovie())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+	}
+	hash := sha256.Sum256(request)
+
+	return &dto.IdempotencyKey{
+		Key:         key,
+		RequestHash: hex.EncodeToString(hash[:]),
+		Since:       time.Now().Add(-s.idempotencyWindow),
+	}, nil
+}
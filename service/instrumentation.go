@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/renaldyhidayatt/movie_grpc/logger"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// instrumentation holds the tracer, logger and request metrics a gRPC service
+// reports through. Each service embeds its own copy under its own metric
+// names.
+type instrumentation struct {
+	trace           trace.Tracer
+	logger          logger.LoggerInterface
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func newInstrumentation(metricPrefix, serviceName string, trace trace.Tracer, logger logger.LoggerInterface) instrumentation {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: metricPrefix + "_requests_total",
+			Help: fmt.Sprintf("Total number of requests to the %s", serviceName),
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    metricPrefix + "_request_duration_seconds",
+			Help:    fmt.Sprintf("Histogram of request durations for the %s", serviceName),
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return instrumentation{
+		trace:           trace,
+		logger:          logger,
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+}
+
+func (s *instrumentation) recordMetrics(method string, status string, startTime time.Time) {
+	duration := time.Since(startTime).Seconds()
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(duration)
+}
+
+func (s *instrumentation) startTracingAndLogging(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (context.Context, func(error)) {
+	start := time.Now()
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(err error) {
+		duration := time.Since(start)
+
+		span.SetAttributes(attribute.Float64("execution_duration_ms", float64(duration.Milliseconds())))
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+			s.logger.Error("Error in "+method,
+				zap.Error(err),
+				zap.Duration("duration", duration),
+			)
+			s.recordMetrics(method, "error", start)
+		} else {
+			span.SetStatus(otelcodes.Ok, "success")
+			s.logger.Info("Success: "+method,
+				zap.Duration("duration", duration),
+			)
+			s.recordMetrics(method, "success", start)
+		}
+
+		span.End()
+	}
+
+	return ctx, end
+}
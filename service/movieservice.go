@@ -3,72 +3,153 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
-	"github.com/prometheus/client_golang/prometheus"
+	"github.com/renaldyhidayatt/movie_grpc/dto"
 	"github.com/renaldyhidayatt/movie_grpc/logger"
 	pb "github.com/renaldyhidayatt/movie_grpc/proto"
 	mencache "github.com/renaldyhidayatt/movie_grpc/redis"
 	"github.com/renaldyhidayatt/movie_grpc/repository"
 	"go.opentelemetry.io/otel/attribute"
-	otelcodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-func generateMovieListKey(page, pageSize int, search string) string {
+func generateMovieListKey(version int64, params dto.MovieListParams) string {
+	search := params.Search
 	if search == "" {
 		search = "_"
 	}
-	return fmt.Sprintf("movie:list:page=%d:size=%d:search=%s", page, pageSize, search)
+	genre, _ := repository.NormalizeGenre(params.Genre)
+	if genre == "" {
+		genre = "_"
+	}
+	order := params.OrderBy
+	if order == "" {
+		order = "_"
+	}
+	createdAfter := "_"
+	if !params.CreatedAfter.IsZero() {
+		createdAfter = strconv.FormatInt(params.CreatedAfter.UnixNano(), 10)
+	}
+	token := params.PageToken
+	if token == "" {
+		token = "_"
+	}
+	return fmt.Sprintf("movie:list:v=%d:page=%d:size=%d:search=%s:genre=%s:order=%s:year=%d-%d:created_after=%s:rating=%s-%s:token=%s:total=%t",
+		version, params.Page, params.PageSize, search, genre, order,
+		params.MinReleaseYear, params.MaxReleaseYear, createdAfter,
+		formatRatingBound(params.MinRating), formatRatingBound(params.MaxRating),
+		token, params.IncludeTotal,
+	)
+}
+
+func formatRatingBound(bound *float64) string {
+	if bound == nil {
+		return "_"
+	}
+	return strconv.FormatFloat(*bound, 'g', -1, 64)
+}
+
+func movieFlightKey(id string) string {
+	return fmt.Sprintf("movie:id:%s", id)
 }
 
 type MovieService struct {
-	trace           trace.Tracer
-	logger          logger.LoggerInterface
-	mencache        mencache.MovieServiceCache
-	repo            repository.MovieRepository
-	requestCounter  *prometheus.CounterVec
-	requestDuration *prometheus.HistogramVec
+	instrumentation
+	mencache   mencache.MovieServiceCache
+	repo       repository.MovieRepository
+	genreRepo  repository.GenreRepository
+	creditRepo repository.CreditRepository
+	eventRepo  repository.MovieEventRepository
+	// flight shares movie fetches and listFlight list fetches. They are kept
+	// apart because movie ids are chosen by clients and could otherwise name
+	// a list key.
+	flight     singleflight.Group
+	listFlight singleflight.Group
+	batchLimit int
+	// idempotencyWindow is how long CreateMovie remembers idempotency keys.
+	idempotencyWindow time.Duration
+	// watchPollInterval is how often a caught-up WatchMovies stream looks
+	// for new events.
+	watchPollInterval time.Duration
+	// stopping is closed by Stop to end the streams that would otherwise
+	// stay open until their clients leave.
+	stopping chan struct{}
+	stopOnce sync.Once
 	pb.UnimplementedMovieServiceServer
 }
 
-func NewMovieService(repo repository.MovieRepository, trace trace.Tracer, logger logger.LoggerInterface, mencache mencache.MovieServiceCache) *MovieService {
-	requestCounter := prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "movie_service_requests_total",
-			Help: "Total number of requests to the MovieService",
-		},
-		[]string{"method", "status"},
-	)
+// DefaultBatchLimit is how many items a batch call may carry unless
+// WithBatchLimit says otherwise.
+const DefaultBatchLimit = 100
 
-	requestDuration := prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Name:    "movie_service_request_duration_seconds",
-			Help:    "Histogram of request durations for the MovieService",
-			Buckets: prometheus.DefBuckets,
-		},
-		[]string{"method"},
-	)
+type MovieServiceOption func(*MovieService)
+
+// WithBatchLimit caps the number of IDs or movies a single batch call may
+// carry. Larger batches are rejected with InvalidArgument.
+func WithBatchLimit(limit int) MovieServiceOption {
+	return func(s *MovieService) {
+		s.batchLimit = limit
+	}
+}
 
-	prometheus.MustRegister(requestCounter, requestDuration)
+// DefaultIdempotencyWindow is how long idempotency keys are remembered unless
+// WithIdempotencyWindow says otherwise.
+const DefaultIdempotencyWindow = 24 * time.Hour
 
-	return &MovieService{
-		repo:            repo,
-		trace:           trace,
-		logger:          logger,
-		mencache:        mencache,
-		requestCounter:  requestCounter,
-		requestDuration: requestDuration,
+// WithIdempotencyWindow sets how long a CreateMovie idempotency key is
+// remembered. Zero turns idempotency keys off.
+func WithIdempotencyWindow(window time.Duration) MovieServiceOption {
+	return func(s *MovieService) {
+		s.idempotencyWindow = window
 	}
 }
 
-func (s *MovieService) recordMetrics(method string, status string, startTime time.Time) {
-	duration := time.Since(startTime).Seconds()
-	s.requestCounter.WithLabelValues(method, status).Inc()
-	s.requestDuration.WithLabelValues(method).Observe(duration)
+// DefaultWatchPollInterval is how often WatchMovies polls the outbox unless
+// WithWatchPollInterval says otherwise.
+const DefaultWatchPollInterval = 500 * time.Millisecond
+
+// WithWatchPollInterval sets how often a WatchMovies stream that has sent
+// every event polls the outbox for new ones. Every open stream polls on its
+// own.
+func WithWatchPollInterval(interval time.Duration) MovieServiceOption {
+	return func(s *MovieService) {
+		s.watchPollInterval = interval
+	}
+}
+
+func NewMovieService(repo repository.MovieRepository, genreRepo repository.GenreRepository, creditRepo repository.CreditRepository, eventRepo repository.MovieEventRepository, trace trace.Tracer, logger logger.LoggerInterface, mencache mencache.MovieServiceCache, opts ...MovieServiceOption) *MovieService {
+	s := &MovieService{
+		instrumentation:   newInstrumentation("movie_service", "MovieService", trace, logger),
+		repo:              repo,
+		genreRepo:         genreRepo,
+		creditRepo:        creditRepo,
+		eventRepo:         eventRepo,
+		mencache:          mencache,
+		batchLimit:        DefaultBatchLimit,
+		idempotencyWindow: DefaultIdempotencyWindow,
+		watchPollInterval: DefaultWatchPollInterval,
+		stopping:          make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Stop ends every WatchMovies stream with Unavailable, so a server shutting
+// down gracefully need not wait for watchers to disconnect. Clients resume
+// from the last revision they saw. Calls after the first do nothing.
+func (s *MovieService) Stop() {
+	s.stopOnce.Do(func() { close(s.stopping) })
 }
 
 func (s *MovieService) CreateMovie(ctx context.Context, req *pb.CreateMovieRequest) (*pb.CreateMovieResponse, error) {
@@ -76,16 +157,35 @@ func (s *MovieService) CreateMovie(ctx context.Context, req *pb.CreateMovieReque
 	ctx, end := s.startTracingAndLogging(
 		ctx,
 		"CreateMovie",
-		attribute.String("movie.title", req.GetMovie().Title),
+		attribute.String("movie.title", req.GetMovie().GetTitle()),
 	)
 	defer func() { end(err) }()
 
-	movie := req.GetMovie()
-	err = s.repo.CreateMovie(ctx, movie)
+	key, err := s.idempotencyKey(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	movie := req.GetMovie()
+	if key == nil {
+		err = s.repo.CreateMovie(ctx, movie)
+	} else {
+		var replayed bool
+		movie, replayed, err = s.repo.CreateMovieIdempotent(ctx, movie, *key)
+		if err == nil && replayed {
+			grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedHeader, "true"))
+			return &pb.CreateMovieResponse{
+				Movie: movie,
+			}, nil
+		}
+	}
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	s.cacheMovie(ctx, movie)
+	s.invalidateMovieLists(ctx)
+
 	return &pb.CreateMovieResponse{
 		Movie: movie,
 	}, nil
@@ -100,14 +200,58 @@ func (s *MovieService) GetMovie(ctx context.Context, req *pb.ReadMovieRequest) (
 	)
 	defer func() { end(err) }()
 
-	movie, err := s.repo.GetMovie(ctx, req.GetId())
+	movie, err := s.getMovie(ctx, req.GetId())
 	if err != nil {
-		return nil, err
+		return nil, statusError(err, req.GetId())
 	}
 
-	return &pb.ReadMovieResponse{
+	res := &pb.ReadMovieResponse{
 		Movie: movie,
-	}, nil
+	}
+	if req.GetIncludeCredits() {
+		res.Credits, err = s.creditRepo.GetMovieCredits(ctx, movie.GetId())
+		if err != nil {
+			return nil, statusError(err, req.GetId())
+		}
+	}
+
+	return res, nil
+}
+
+// getMovie reads a movie through the cache, falling back to the repository
+// on a miss or a cache failure.
+func (s *MovieService) getMovie(ctx context.Context, id string) (*pb.Movie, error) {
+	cached, cacheErr := s.mencache.GetMovie(ctx, id)
+	if cacheErr != nil {
+		s.logger.Warn("failed to get movie from cache",
+			zap.String("movie.id", id),
+			zap.Error(cacheErr),
+		)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	// Concurrent misses for the same movie share one repository fetch. The
+	// fetch is detached from the caller so one cancelled request cannot fail
+	// the others waiting on it.
+	v, err, _ := s.flight.Do(movieFlightKey(id), func() (interface{}, error) {
+		fetchCtx := context.WithoutCancel(ctx)
+		movie, err := s.repo.GetMovie(fetchCtx, id)
+		if err != nil {
+			return nil, err
+		}
+		s.fillMovieCache(fetchCtx, movie)
+		return movie, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	movie, ok := v.(*pb.Movie)
+	if !ok {
+		return nil, fmt.Errorf("movie fetch returned %T", v)
+	}
+	return movie, nil
 }
 
 func (s *MovieService) GetMovies(ctx context.Context, req *pb.ReadMoviesRequest) (*pb.ReadMoviesResponse, error) {
@@ -126,48 +270,151 @@ func (s *MovieService) GetMovies(ctx context.Context, req *pb.ReadMoviesRequest)
 	if pageSize < 1 {
 		pageSize = 10
 	}
-	search := req.GetSearch()
-	cacheKey := generateMovieListKey(page, pageSize, search)
-
-	result, err := s.mencache.GetMovieList(ctx, cacheKey)
+	order, err := repository.ParseMovieOrder(req.GetOrderBy())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get movie list from cache: %v", err)
+		return nil, statusError(err, "")
+	}
+	params := dto.MovieListParams{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   req.GetSearch(),
+		Genre:    req.GetGenre(),
+		// The canonical form keeps "rating" and "RATING asc" on one cache key.
+		OrderBy:        order.String(),
+		MinReleaseYear: req.GetMinReleaseYear(),
+		MaxReleaseYear: req.GetMaxReleaseYear(),
+		MinRating:      req.MinRating,
+		MaxRating:      req.MaxRating,
+		PageToken:      req.GetPageToken(),
+		// Offset clients have always had a total; token clients opt in.
+		IncludeTotal: req.GetPageToken() == "",
 	}
-	if result != nil {
-		return &pb.ReadMoviesResponse{
-			Movies:       result.Movies,
-			TotalRecords: result.TotalRecords,
-		}, nil
+	if params.PageToken != "" {
+		// The token fixes the position, so page no longer shapes the result.
+		params.Page = 1
+	}
+	if req.IncludeTotalCount != nil {
+		params.IncludeTotal = req.GetIncludeTotalCount()
+	}
+	if req.CreatedAfter != nil {
+		params.CreatedAfter = req.GetCreatedAfter().AsTime()
+	}
+	if err = validateMovieListParams(params); err != nil {
+		return nil, err
 	}
 
-	result, err = s.repo.GetMovies(ctx, page, pageSize, search)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch movies: %v", err)
+	// The version is read before the repository so a page fetched ahead of a
+	// concurrent write can only land under the generation that write retires.
+	// Without a version there is no safe key, so the cache is skipped.
+	version, cacheErr := s.mencache.GetMovieListVersion(ctx)
+	cacheable := cacheErr == nil
+	if !cacheable {
+		s.logger.Warn("failed to get movie list version from cache", zap.Error(cacheErr))
+	}
+	cacheKey := generateMovieListKey(version, params)
+
+	if cacheable {
+		cached, cacheErr := s.mencache.GetMovieList(ctx, cacheKey)
+		if cacheErr != nil {
+			s.logger.Warn("failed to get movie list from cache",
+				zap.String("cache.key", cacheKey),
+				zap.Error(cacheErr),
+			)
+		}
+		if cached != nil {
+			return &pb.ReadMoviesResponse{
+				Movies:        cached.Movies,
+				TotalRecords:  cached.TotalRecords,
+				NextPageToken: cached.NextPageToken,
+			}, nil
+		}
 	}
 
-	_ = s.mencache.SetMovieList(ctx, cacheKey, result)
+	v, err, _ := s.listFlight.Do(cacheKey, func() (interface{}, error) {
+		fetchCtx := context.WithoutCancel(ctx)
+		result, err := s.repo.GetMovies(fetchCtx, params)
+		if err != nil {
+			return nil, err
+		}
+		if cacheable {
+			if cacheErr := s.mencache.SetMovieList(fetchCtx, cacheKey, result); cacheErr != nil {
+				s.logger.Warn("failed to cache movie list",
+					zap.String("cache.key", cacheKey),
+					zap.Error(cacheErr),
+				)
+			}
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+	result, ok := v.(*dto.MovieListResult)
+	if !ok {
+		err = fmt.Errorf("movie list fetch returned %T", v)
+		return nil, statusError(err, "")
+	}
 
 	return &pb.ReadMoviesResponse{
-		Movies:       result.Movies,
-		TotalRecords: result.TotalRecords,
+		Movies:        result.Movies,
+		TotalRecords:  result.TotalRecords,
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
+func (s *MovieService) SearchMovies(ctx context.Context, req *pb.SearchMoviesRequest) (*pb.SearchMoviesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"SearchMovies",
+		attribute.String("search.query", req.GetQuery()),
+	)
+	defer func() { end(err) }()
+
+	results, total, err := s.repo.SearchMovies(ctx, req.GetQuery(), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.SearchMoviesResponse{
+		Results:      results,
+		TotalRecords: total,
+	}, nil
+}
+
+func validateMovieListParams(params dto.MovieListParams) error {
+	if params.MinReleaseYear != 0 && params.MaxReleaseYear != 0 && params.MinReleaseYear > params.MaxReleaseYear {
+		return status.Error(codes.InvalidArgument, "min_release_year is after max_release_year")
+	}
+	if params.MinRating != nil && params.MaxRating != nil && *params.MinRating > *params.MaxRating {
+		return status.Error(codes.InvalidArgument, "min_rating is greater than max_rating")
+	}
+	return nil
+}
+
 func (s *MovieService) UpdateMovie(ctx context.Context, req *pb.UpdateMovieRequest) (*pb.UpdateMovieResponse, error) {
 	var err error
 	ctx, end := s.startTracingAndLogging(
 		ctx,
 		"UpdateMovie",
-		attribute.String("movie.id", req.GetMovie().Id),
+		attribute.String("movie.id", req.GetMovie().GetId()),
 	)
 	defer func() { end(err) }()
 
+	mask, err := repository.ParseMovieUpdateMask(req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
 	movie := req.GetMovie()
-	updatedMovie, err := s.repo.UpdateMovie(ctx, movie)
+	updatedMovie, err := s.repo.UpdateMovie(ctx, movie, mask, req.GetExpectedVersion())
 	if err != nil {
-		return nil, err
+		return nil, statusError(err, movie.GetId())
 	}
 
+	s.cacheMovie(ctx, updatedMovie)
+	s.invalidateMovieLists(ctx)
+
 	return &pb.UpdateMovieResponse{
 		Movie: updatedMovie,
 	}, nil
@@ -182,54 +429,50 @@ func (s *MovieService) DeleteMovie(ctx context.Context, req *pb.DeleteMovieReque
 	)
 	defer func() { end(err) }()
 
-	err = s.repo.DeleteMovie(ctx, req.GetId())
+	err = s.repo.DeleteMovie(ctx, req.GetId(), req.GetExpectedVersion())
 	if err != nil {
-		return nil, err
+		return nil, statusError(err, req.GetId())
 	}
 
+	s.evictMovie(ctx, req.GetId())
+	s.invalidateMovieLists(ctx)
+
 	return &pb.DeleteMovieResponse{
 		Success: true,
 	}, nil
 }
 
-func (s *MovieService) startTracingAndLogging(
-	ctx context.Context,
-	method string,
-	attrs ...attribute.KeyValue,
-) (context.Context, func(error)) {
-	start := time.Now()
-	ctx, span := s.trace.Start(ctx, method)
-
-	if len(attrs) > 0 {
-		span.SetAttributes(attrs...)
+func (s *MovieService) cacheMovie(ctx context.Context, movie *pb.Movie) {
+	if err := s.mencache.SetMovie(ctx, movie); err != nil {
+		s.logger.Warn("failed to cache movie",
+			zap.String("movie.id", movie.GetId()),
+			zap.Error(err),
+		)
 	}
-	span.AddEvent("Start: " + method)
-
-	s.logger.Debug("Start: " + method)
-
-	end := func(err error) {
-		duration := time.Since(start)
-
-		span.SetAttributes(attribute.Float64("execution_duration_ms", float64(duration.Milliseconds())))
+}
 
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelcodes.Error, err.Error())
-			s.logger.Error("Error in "+method,
-				zap.Error(err),
-				zap.Duration("duration", duration),
-			)
-			s.recordMetrics(method, "error", start)
-		} else {
-			span.SetStatus(otelcodes.Ok, "success")
-			s.logger.Info("Success: "+method,
-				zap.Duration("duration", duration),
-			)
-			s.recordMetrics(method, "success", start)
-		}
+// fillMovieCache caches a movie after a read miss. Unlike cacheMovie it does
+// not announce a write, which would evict the movie from other replicas.
+func (s *MovieService) fillMovieCache(ctx context.Context, movie *pb.Movie) {
+	if err := s.mencache.FillMovie(ctx, movie); err != nil {
+		s.logger.Warn("failed to cache movie",
+			zap.String("movie.id", movie.GetId()),
+			zap.Error(err),
+		)
+	}
+}
 
-		span.End()
+func (s *MovieService) evictMovie(ctx context.Context, id string) {
+	if err := s.mencache.DeleteMovie(ctx, id); err != nil {
+		s.logger.Warn("failed to evict movie from cache",
+			zap.String("movie.id", id),
+			zap.Error(err),
+		)
 	}
+}
 
-	return ctx, end
+func (s *MovieService) invalidateMovieLists(ctx context.Context) {
+	if err := s.mencache.InvalidateMovieLists(ctx); err != nil {
+		s.logger.Warn("failed to invalidate movie lists", zap.Error(err))
+	}
 }
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+
+	"github.com/renaldyhidayatt/movie_grpc/logger"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"github.com/renaldyhidayatt/movie_grpc/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type PeopleService struct {
+	instrumentation
+	personRepo repository.PersonRepository
+	creditRepo repository.CreditRepository
+	pb.UnimplementedPeopleServiceServer
+}
+
+func NewPeopleService(personRepo repository.PersonRepository, creditRepo repository.CreditRepository, trace trace.Tracer, logger logger.LoggerInterface) *PeopleService {
+	return &PeopleService{
+		instrumentation: newInstrumentation("people_service", "PeopleService", trace, logger),
+		personRepo:      personRepo,
+		creditRepo:      creditRepo,
+	}
+}
+
+func (s *PeopleService) CreatePerson(ctx context.Context, req *pb.CreatePersonRequest) (*pb.CreatePersonResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"CreatePerson",
+		attribute.String("person.name", req.GetPerson().GetName()),
+	)
+	defer func() { end(err) }()
+
+	person := req.GetPerson()
+	err = s.personRepo.CreatePerson(ctx, person)
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.CreatePersonResponse{
+		Person: person,
+	}, nil
+}
+
+func (s *PeopleService) GetPerson(ctx context.Context, req *pb.ReadPersonRequest) (*pb.ReadPersonResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"GetPerson",
+		attribute.String("person.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	person, err := s.personRepo.GetPerson(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	return &pb.ReadPersonResponse{
+		Person: person,
+	}, nil
+}
+
+func (s *PeopleService) GetPeople(ctx context.Context, req *pb.ReadPeopleRequest) (*pb.ReadPeopleResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"GetPeople",
+	)
+	defer func() { end(err) }()
+
+	people, total, err := s.personRepo.GetPeople(ctx, int(req.GetPage()), int(req.GetPageSize()), req.GetSearch())
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.ReadPeopleResponse{
+		People:       people,
+		TotalRecords: total,
+	}, nil
+}
+
+func (s *PeopleService) UpdatePerson(ctx context.Context, req *pb.UpdatePersonRequest) (*pb.UpdatePersonResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"UpdatePerson",
+		attribute.String("person.id", req.GetPerson().GetId()),
+	)
+	defer func() { end(err) }()
+
+	person, err := s.personRepo.UpdatePerson(ctx, req.GetPerson())
+	if err != nil {
+		return nil, statusError(err, req.GetPerson().GetId())
+	}
+
+	return &pb.UpdatePersonResponse{
+		Person: person,
+	}, nil
+}
+
+func (s *PeopleService) DeletePerson(ctx context.Context, req *pb.DeletePersonRequest) (*pb.DeletePersonResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"DeletePerson",
+		attribute.String("person.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	err = s.personRepo.DeletePerson(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	return &pb.DeletePersonResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *PeopleService) CreateCredit(ctx context.Context, req *pb.CreateCreditRequest) (*pb.CreateCreditResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"CreateCredit",
+		attribute.String("movie.id", req.GetCredit().GetMovieId()),
+		attribute.String("person.id", req.GetCredit().GetPersonId()),
+	)
+	defer func() { end(err) }()
+
+	credit := req.GetCredit()
+	err = s.creditRepo.CreateCredit(ctx, credit)
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.CreateCreditResponse{
+		Credit: credit,
+	}, nil
+}
+
+func (s *PeopleService) DeleteCredit(ctx context.Context, req *pb.DeleteCreditRequest) (*pb.DeleteCreditResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"DeleteCredit",
+		attribute.String("credit.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	err = s.creditRepo.DeleteCredit(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	return &pb.DeleteCreditResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *PeopleService) GetPersonMovies(ctx context.Context, req *pb.ReadPersonMoviesRequest) (*pb.ReadPersonMoviesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"GetPersonMovies",
+		attribute.String("person.id", req.GetPersonId()),
+		attribute.String("credit.role", req.GetRole().String()),
+	)
+	defer func() { end(err) }()
+
+	movies, err := s.creditRepo.GetPersonMovies(ctx, req.GetPersonId(), req.GetRole())
+	if err != nil {
+		return nil, statusError(err, req.GetPersonId())
+	}
+
+	return &pb.ReadPersonMoviesResponse{
+		Movies: movies,
+	}, nil
+}
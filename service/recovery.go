@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/renaldyhidayatt/movie_grpc/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryServerInterceptor turns a panic in a handler into an Internal
+// error for that call, so one bad request cannot take the server down.
+func RecoveryUnaryServerInterceptor(logger logger.LoggerInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logger, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamServerInterceptor does for streams what
+// RecoveryUnaryServerInterceptor does for unary calls.
+func RecoveryStreamServerInterceptor(logger logger.LoggerInterface) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logger, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(logger logger.LoggerInterface, method string, r any) error {
+	logger.Error("recovered from panic",
+		zap.String("grpc.method", method),
+		zap.Any("panic", r),
+		zap.ByteString("stack", debug.Stack()),
+	)
+	return status.Error(codes.Internal, "internal error")
+}
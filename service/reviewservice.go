@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"github.com/renaldyhidayatt/movie_grpc/logger"
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	mencache "github.com/renaldyhidayatt/movie_grpc/redis"
+	"github.com/renaldyhidayatt/movie_grpc/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type ReviewService struct {
+	instrumentation
+	mencache   mencache.MovieServiceCache
+	reviewRepo repository.ReviewRepository
+	pb.UnimplementedReviewServiceServer
+}
+
+func NewReviewService(reviewRepo repository.ReviewRepository, trace trace.Tracer, logger logger.LoggerInterface, mencache mencache.MovieServiceCache) *ReviewService {
+	return &ReviewService{
+		instrumentation: newInstrumentation("review_service", "ReviewService", trace, logger),
+		reviewRepo:      reviewRepo,
+		mencache:        mencache,
+	}
+}
+
+func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"CreateReview",
+		attribute.String("movie.id", req.GetReview().GetMovieId()),
+	)
+	defer func() { end(err) }()
+
+	review := req.GetReview()
+	err = s.reviewRepo.CreateReview(ctx, review)
+	if err != nil {
+		return nil, statusError(err, req.GetReview().GetMovieId())
+	}
+
+	s.refreshMovieRating(ctx, review.GetMovieId())
+
+	return &pb.CreateReviewResponse{
+		Review: review,
+	}, nil
+}
+
+func (s *ReviewService) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.UpdateReviewResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"UpdateReview",
+		attribute.String("review.id", req.GetReview().GetId()),
+	)
+	defer func() { end(err) }()
+
+	review, err := s.reviewRepo.UpdateReview(ctx, req.GetReview())
+	if err != nil {
+		return nil, statusError(err, req.GetReview().GetId())
+	}
+
+	s.refreshMovieRating(ctx, review.GetMovieId())
+
+	return &pb.UpdateReviewResponse{
+		Review: review,
+	}, nil
+}
+
+func (s *ReviewService) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*pb.DeleteReviewResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"DeleteReview",
+		attribute.String("review.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	review, err := s.reviewRepo.DeleteReview(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	s.refreshMovieRating(ctx, review.GetMovieId())
+
+	return &pb.DeleteReviewResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *ReviewService) GetReviews(ctx context.Context, req *pb.ReadReviewsRequest) (*pb.ReadReviewsResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"GetReviews",
+		attribute.String("movie.id", req.GetMovieId()),
+	)
+	defer func() { end(err) }()
+
+	reviews, total, err := s.reviewRepo.GetReviews(ctx, req.GetMovieId(), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, statusError(err, req.GetMovieId())
+	}
+
+	return &pb.ReadReviewsResponse{
+		Reviews:      reviews,
+		TotalRecords: total,
+	}, nil
+}
+
+// refreshMovieRating drops cached copies of a movie whose rating just changed.
+// Lists are invalidated as well since they embed the rating and may be sorted
+// by it.
+func (s *ReviewService) refreshMovieRating(ctx context.Context, movieID string) {
+	if err := s.mencache.DeleteMovie(ctx, movieID); err != nil {
+		s.logger.Warn("failed to evict movie from cache",
+			zap.String("movie.id", movieID),
+			zap.Error(err),
+		)
+	}
+	if err := s.mencache.InvalidateMovieLists(ctx); err != nil {
+		s.logger.Warn("failed to invalidate movie lists", zap.Error(err))
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"github.com/renaldyhidayatt/movie_grpc/validation"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultStreamBatchSize = 100
+	maxStreamBatchSize     = 1000
+	// importBatchSize is how many imported movies are written per transaction.
+	importBatchSize = 100
+	// watchBatchSize is how many events WatchMovies reads at a time.
+	watchBatchSize = 100
+)
+
+// StreamMovies sends every movie in the catalog. Movies are read in batches,
+// so memory use does not grow with the catalog.
+func (s *MovieService) StreamMovies(req *pb.StreamMoviesRequest, stream pb.MovieService_StreamMoviesServer) error {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		stream.Context(),
+		"StreamMovies",
+		attribute.Int("stream.batch_size", int(req.GetBatchSize())),
+	)
+	defer func() { end(err) }()
+
+	batchSize := int(req.GetBatchSize())
+	if batchSize < 1 {
+		batchSize = defaultStreamBatchSize
+	}
+	batchSize = min(batchSize, maxStreamBatchSize)
+
+	err = s.repo.StreamMovies(ctx, batchSize, func(movies []*pb.Movie) error {
+		for _, movie := range movies {
+			if err := stream.Send(movie); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// ImportMovies creates or updates every movie the client sends and answers
+// with a summary once the client closes its side. Movies are written in
+// batches of importBatchSize; a batch that is committed stays committed even
+// if the stream later fails.
+func (s *MovieService) ImportMovies(stream pb.MovieService_ImportMoviesServer) error {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		stream.Context(),
+		"ImportMovies",
+	)
+	defer func() { end(err) }()
+
+	var (
+		res     pb.ImportMoviesResponse
+		batch   []*pb.Movie
+		indexes []int32
+		next    int32
+	)
+	fail := func(index int32, id string, err error) {
+		res.Failed++
+		res.Errors = append(res.Errors, &pb.ImportError{
+			Index:   index,
+			Id:      id,
+			Message: err.Error(),
+		})
+	}
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := s.repo.ImportMovies(ctx, batch)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to import movies: %v", err)
+		}
+
+		changed := false
+		for i, result := range results {
+			movie := batch[i]
+			switch {
+			case result.Err != nil:
+				fail(indexes[i], movie.GetId(), result.Err)
+				continue
+			case result.Created:
+				res.Created++
+			default:
+				res.Updated++
+				s.evictMovie(ctx, movie.GetId())
+			}
+			changed = true
+		}
+		if changed {
+			s.invalidateMovieLists(ctx)
+		}
+
+		batch, indexes = batch[:0], indexes[:0]
+		return nil
+	}
+
+	for ; ; next++ {
+		req, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+		if recvErr != nil {
+			err = recvErr
+			return err
+		}
+
+		if err := validation.Validate(req); err != nil {
+			fail(next, req.GetMovie().GetId(), err)
+			continue
+		}
+		batch = append(batch, req.GetMovie())
+		indexes = append(indexes, next)
+		if len(batch) == importBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = flush(); err != nil {
+		return err
+	}
+
+	err = stream.SendAndClose(&res)
+	return err
+}
+
+// WatchMovies streams movie changes from the outbox until the client goes
+// away. The outbox lives in the database, so every replica sees every change
+// no matter which one made it.
+func (s *MovieService) WatchMovies(req *pb.WatchMoviesRequest, stream pb.MovieService_WatchMoviesServer) error {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		stream.Context(),
+		"WatchMovies",
+		attribute.Int64("watch.after_revision", req.GetAfterRevision()),
+	)
+	defer func() { end(err) }()
+
+	after := req.GetAfterRevision()
+	if req.AfterRevision == nil {
+		after, err = s.eventRepo.LatestMovieRevision(ctx)
+		if err != nil {
+			err = status.Errorf(codes.Internal, "failed to read movie revision: %v", err)
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(s.watchPollInterval)
+	defer ticker.Stop()
+	for {
+		events, fetchErr := s.movieEvents(ctx, after)
+		if fetchErr != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			err = fetchErr
+			return err
+		}
+		// Revisions have no gaps, so one here means the events the client
+		// has not seen yet were pruned from the outbox.
+		if len(events) > 0 && events[0].GetRevision() > after+1 {
+			err = status.Errorf(codes.OutOfRange, "events after revision %d have been pruned; watch again without after_revision", after)
+			return err
+		}
+		for _, event := range events {
+			if err = stream.Send(event); err != nil {
+				return err
+			}
+			after = event.GetRevision()
+		}
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-s.stopping:
+			err = status.Error(codes.Unavailable, "server is shutting down")
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+// movieEvents reads the next events after the given revision and attaches
+// the current movies to them. Movies come from the repository, not the cache,
+// which is only evicted after the change has committed.
+func (s *MovieService) movieEvents(ctx context.Context, after int64) ([]*pb.MovieEvent, error) {
+	events, err := s.eventRepo.GetMovieEvents(ctx, after, watchBatchSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read movie events: %v", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	var ids []string
+	for _, event := range events {
+		if event.GetType() != pb.MovieEventType_MOVIE_EVENT_TYPE_DELETED {
+			ids = append(ids, event.GetMovieId())
+		}
+	}
+	if len(ids) == 0 {
+		return events, nil
+	}
+	movies, err := s.repo.GetMoviesByID(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch movies: %v", err)
+	}
+	for _, event := range events {
+		if event.GetType() != pb.MovieEventType_MOVIE_EVENT_TYPE_DELETED {
+			event.Movie = movies[event.GetMovieId()]
+		}
+	}
+	return events, nil
+}
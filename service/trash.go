@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+func (s *MovieService) UndeleteMovie(ctx context.Context, req *pb.UndeleteMovieRequest) (*pb.UndeleteMovieResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"UndeleteMovie",
+		attribute.String("movie.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	movie, err := s.repo.UndeleteMovie(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	s.cacheMovie(ctx, movie)
+	s.invalidateMovieLists(ctx)
+
+	return &pb.UndeleteMovieResponse{
+		Movie: movie,
+	}, nil
+}
+
+func (s *MovieService) ListDeletedMovies(ctx context.Context, req *pb.ListDeletedMoviesRequest) (*pb.ListDeletedMoviesResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"ListDeletedMovies",
+	)
+	defer func() { end(err) }()
+
+	movies, total, err := s.repo.ListDeletedMovies(ctx, int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, statusError(err, "")
+	}
+
+	return &pb.ListDeletedMoviesResponse{
+		Movies:       movies,
+		TotalRecords: total,
+	}, nil
+}
+
+// PurgeMovie removes a deleted movie for good. Deleted movies are neither
+// cached nor listed, so there is nothing to evict.
+func (s *MovieService) PurgeMovie(ctx context.Context, req *pb.PurgeMovieRequest) (*pb.PurgeMovieResponse, error) {
+	var err error
+	ctx, end := s.startTracingAndLogging(
+		ctx,
+		"PurgeMovie",
+		attribute.String("movie.id", req.GetId()),
+	)
+	defer func() { end(err) }()
+
+	err = s.repo.PurgeMovie(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err, req.GetId())
+	}
+
+	return &pb.PurgeMovieResponse{
+		Success: true,
+	}, nil
+}
+
+// Cleanup runs the background housekeeping every interval: it purges movies
+// deleted for longer than trashRetention and prunes outbox events older than
+// eventRetention. A retention of zero skips that step. It returns when ctx is
+// done.
+func (s *MovieService) Cleanup(ctx context.Context, interval, trashRetention, eventRetention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if trashRetention > 0 {
+			n, err := s.repo.PurgeDeletedMovies(ctx, time.Now().Add(-trashRetention))
+			if err != nil {
+				s.logger.Error("failed to purge deleted movies", zap.Error(err))
+			} else if n > 0 {
+				s.logger.Info("purged deleted movies", zap.Int64("count", n))
+			}
+		}
+		if eventRetention > 0 {
+			n, err := s.eventRepo.PruneMovieEvents(ctx, time.Now().Add(-eventRetention))
+			if err != nil {
+				s.logger.Error("failed to prune movie events", zap.Error(err))
+			} else if n > 0 {
+				s.logger.Info("pruned movie events", zap.Int64("count", n))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	maxTitleLength    = 200
+	maxGenreLength    = 50
+	maxGenres         = 10
+	maxSynopsisLength = 5000
+	maxLanguageLength = 35
+	maxURLLength      = 2048
+	maxQueryLength    = 200
+	maxPageSize       = 1000
+	maxRequestID      = 128
+	// The first films date from 1878; anything past maxReleaseYear is a typo.
+	minReleaseYear = 1878
+	maxReleaseYear = 2100
+	maxRuntime     = 1000
+)
+
+// fieldRules are the rules for the field at path, relative to the message
+// they are declared on. Paths may reach into nested messages, such as
+// "movie.title", which lets a request add rules to a shared message.
+type fieldRules struct {
+	path  string
+	rules []rule
+}
+
+func field(path string, rules ...rule) fieldRules {
+	return fieldRules{path: path, rules: rules}
+}
+
+var pageRules = []fieldRules{
+	field("page", atLeast(0)),
+	field("page_size", between(0, maxPageSize)),
+}
+
+// messageRules holds the rules of every message that has any. The rules of
+// Movie and Genre hold wherever those messages appear; the request rules say
+// what a call needs on top of that.
+var messageRules = rulesByMessage(map[proto.Message][]fieldRules{
+	&pb.Movie{}: {
+		field("title", maxLength(maxTitleLength)),
+		field("genre", maxLength(maxGenres*(maxGenreLength+2))),
+		field("genres", maxItems(maxGenres), maxLength(maxGenreLength)),
+		field("release_year", between(minReleaseYear, maxReleaseYear)),
+		field("runtime_minutes", between(1, maxRuntime)),
+		field("synopsis", maxLength(maxSynopsisLength)),
+		field("original_language", maxLength(maxLanguageLength)),
+		field("age_rating", definedEnum()),
+		field("poster_url", maxLength(maxURLLength), httpURL()),
+	},
+	&pb.Genre{}: {
+		field("name", maxLength(maxGenreLength)),
+	},
+
+	&pb.CreateMovieRequest{}: {
+		field("movie", required()),
+		field("movie.id", unset()),
+		field("movie.title", required()),
+		field("request_id", maxLength(maxRequestID)),
+	},
+	&pb.ImportMoviesRequest{}: {
+		field("movie", required()),
+		field("movie.title", required()),
+	},
+	&pb.ReadMovieRequest{}: {
+		field("id", required()),
+	},
+	&pb.ReadMoviesRequest{}: append([]fieldRules{
+		field("search", maxLength(maxQueryLength)),
+		field("genre", maxLength(maxGenreLength)),
+		field("min_rating", between(0, 10)),
+		field("max_rating", between(0, 10)),
+	}, pageRules...),
+	&pb.SearchMoviesRequest{}: append([]fieldRules{
+		field("query", required(), maxLength(maxQueryLength)),
+	}, pageRules...),
+	&pb.StreamMoviesRequest{}: {
+		field("batch_size", atLeast(0)),
+	},
+	&pb.WatchMoviesRequest{}: {
+		field("after_revision", atLeast(0)),
+	},
+	&pb.UpdateMovieRequest{}: {
+		field("movie", required()),
+		field("movie.id", required()),
+		field("expected_version", atLeast(1)),
+	},
+	&pb.DeleteMovieRequest{}: {
+		field("id", required()),
+		field("expected_version", atLeast(1)),
+	},
+	&pb.UndeleteMovieRequest{}: {
+		field("id", required()),
+	},
+	&pb.ListDeletedMoviesRequest{}: pageRules,
+	&pb.PurgeMovieRequest{}: {
+		field("id", required()),
+	},
+	&pb.FindDuplicatesRequest{}: {
+		field("min_similarity", between(0, 1)),
+		field("limit", between(0, maxPageSize)),
+	},
+
+	&pb.CreatePersonRequest{}: {
+		field("person", required()),
+		field("person.id", unset()),
+		field("person.name", required()),
+	},
+	&pb.ReadPersonRequest{}: {
+		field("id", required()),
+	},
+	&pb.ReadPeopleRequest{}: append([]fieldRules{
+		field("search", maxLength(maxQueryLength)),
+	}, pageRules...),
+	&pb.UpdatePersonRequest{}: {
+		field("person", required()),
+		field("person.id", required()),
+	},
+	&pb.DeletePersonRequest{}: {
+		field("id", required()),
+	},
+	&pb.CreateCreditRequest{}: {
+		field("credit", required()),
+		field("credit.id", unset()),
+		field("credit.movie_id", required()),
+		field("credit.person_id", required()),
+		field("credit.role", definedEnum()),
+	},
+	&pb.DeleteCreditRequest{}: {
+		field("id", required()),
+	},
+	&pb.ReadPersonMoviesRequest{}: {
+		field("person_id", required()),
+		field("role", definedEnum()),
+	},
+
+	&pb.CreateReviewRequest{}: {
+		field("review", required()),
+		field("review.id", unset()),
+		field("review.movie_id", required()),
+	},
+	&pb.UpdateReviewRequest{}: {
+		field("review", required()),
+		field("review.id", required()),
+	},
+	&pb.DeleteReviewRequest{}: {
+		field("id", required()),
+	},
+	&pb.ReadReviewsRequest{}: pageRules,
+
+	&pb.CreateGenreRequest{}: {
+		field("genre", required()),
+		field("genre.id", unset()),
+		field("genre.name", required()),
+	},
+	&pb.ReadGenreRequest{}: {
+		field("id", required()),
+	},
+	&pb.UpdateGenreRequest{}: {
+		field("genre", required()),
+		field("genre.id", required()),
+	},
+	&pb.DeleteGenreRequest{}: {
+		field("id", required()),
+	},
+})
+
+// rulesByMessage keys the rules by message name and checks that every path
+// names a field, so a typo fails at startup rather than skipping a rule.
+func rulesByMessage(rules map[proto.Message][]fieldRules) map[protoreflect.FullName][]fieldRules {
+	byName := make(map[protoreflect.FullName][]fieldRules, len(rules))
+	for msg, fields := range rules {
+		md := msg.ProtoReflect().Descriptor()
+		for _, f := range fields {
+			if err := checkPath(md, f.path); err != nil {
+				panic(fmt.Sprintf("validation: %s: %v", md.FullName(), err))
+			}
+		}
+		byName[md.FullName()] = fields
+	}
+	return byName
+}
+
+func checkPath(md protoreflect.MessageDescriptor, path string) error {
+	var fd protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if fd != nil {
+			if md = fd.Message(); md == nil || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("%s: %s is not a singular message", path, fd.Name())
+			}
+		}
+		if fd = md.Fields().ByName(protoreflect.Name(name)); fd == nil {
+			return fmt.Errorf("%s: no field %s", path, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+// Package validation checks requests against the field rules declared in
+// rules.go before they reach a service.
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation is one field that failed its rules. Field is the path of the
+// field in the request, such as "movie.title" or "movie.genres[2]".
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Error lists every field of a message that failed its rules, one violation
+// per field. It converts to an InvalidArgument status with a BadRequest
+// detail.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return strings.Join(parts, "; ")
+}
+
+// GRPCStatus lets status.FromError and status.Convert see an Error as an
+// InvalidArgument status.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, "invalid request: "+e.Error())
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(e.Violations)),
+	}
+	for i, v := range e.Violations {
+		badRequest.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		}
+	}
+	if withDetails, err := st.WithDetails(badRequest); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// Validate checks msg, and every message set in its singular fields, against
+// their rules. It returns an *Error, or nil when msg is valid or has no
+// rules. Elements of repeated message fields are not checked: handlers that
+// accept many items validate each on its own so one bad item does not fail
+// the rest.
+func Validate(msg proto.Message) error {
+	var violations []Violation
+	validate(msg.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &Error{Violations: violations}
+}
+
+func validate(m protoreflect.Message, prefix string, violations *[]Violation) {
+	if !m.IsValid() {
+		return
+	}
+
+	for _, f := range messageRules[m.Descriptor().FullName()] {
+		parent, fd, ok := resolve(m, f.path)
+		if !ok {
+			continue
+		}
+		if field, description := check(parent, fd, f.rules); description != "" {
+			*violations = append(*violations, Violation{
+				Field:       prefix + f.path + field,
+				Description: description,
+			})
+		}
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			validate(v.Message(), prefix+string(fd.Name())+".", violations)
+		}
+		return true
+	})
+}
+
+// resolve finds the message holding the last field of path, such as movie
+// for "movie.title". It reports false when a message along the way is unset;
+// a rule on that message reports it if it is required.
+func resolve(m protoreflect.Message, path string) (protoreflect.Message, protoreflect.FieldDescriptor, bool) {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if !m.Has(fd) {
+			return nil, nil, false
+		}
+		m = m.Get(fd).Message()
+	}
+	return m, m.Descriptor().Fields().ByName(protoreflect.Name(names[len(names)-1])), true
+}
+
+// check applies rules to the field fd of m and returns the first failure. For
+// a failing list element, field is its index, such as "[2]".
+func check(m protoreflect.Message, fd protoreflect.FieldDescriptor, rules []rule) (field, description string) {
+	for _, r := range rules {
+		if r.field != nil {
+			if description := r.field(m, fd); description != "" {
+				return "", description
+			}
+			continue
+		}
+		if !m.Has(fd) {
+			continue
+		}
+		v := m.Get(fd)
+		if !fd.IsList() {
+			if description := r.value(fd, v); description != "" {
+				return "", description
+			}
+			continue
+		}
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			if description := r.value(fd, list.Get(i)); description != "" {
+				return fmt.Sprintf("[%d]", i), description
+			}
+		}
+	}
+	return "", ""
+}
+
+// A rule checks either a field as a whole, set or not, or each value of a set
+// field: the value itself, or every element of a list. Either check returns
+// what is wrong, or "" when nothing is.
+type rule struct {
+	field func(m protoreflect.Message, fd protoreflect.FieldDescriptor) string
+	value func(fd protoreflect.FieldDescriptor, v protoreflect.Value) string
+}
+
+// required fails when the field is unset. Scalars count as unset when they
+// hold their zero value, unless they are declared optional.
+func required() rule {
+	return rule{field: func(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if !m.Has(fd) {
+			return "is required"
+		}
+		return ""
+	}}
+}
+
+// unset fails when the field is set, for values the server assigns.
+func unset() rule {
+	return rule{field: func(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if m.Has(fd) {
+			return "must not be set"
+		}
+		return ""
+	}}
+}
+
+func maxItems(n int) rule {
+	return rule{field: func(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if m.Get(fd).List().Len() > n {
+			return fmt.Sprintf("must have at most %d items", n)
+		}
+		return ""
+	}}
+}
+
+// maxLength bounds a string in characters, not bytes.
+func maxLength(n int) rule {
+	return rule{value: func(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+		if utf8.RuneCountInString(v.String()) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+		return ""
+	}}
+}
+
+func atLeast(min float64) rule {
+	return rule{value: func(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+		if number(fd, v) < min {
+			return fmt.Sprintf("must be at least %v", min)
+		}
+		return ""
+	}}
+}
+
+func between(min, max float64) rule {
+	return rule{value: func(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+		if n := number(fd, v); n < min || n > max {
+			return fmt.Sprintf("must be between %v and %v", min, max)
+		}
+		return ""
+	}}
+}
+
+func number(fd protoreflect.FieldDescriptor, v protoreflect.Value) float64 {
+	switch fd.Kind() {
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return v.Float()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(v.Uint())
+	default:
+		return float64(v.Int())
+	}
+}
+
+// definedEnum fails for numbers the enum does not declare.
+func definedEnum() rule {
+	return rule{value: func(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+		if fd.Enum().Values().ByNumber(v.Enum()) == nil {
+			return fmt.Sprintf("is not a known %s", fd.Enum().Name())
+		}
+		return ""
+	}}
+}
+
+// httpURL fails for anything but an absolute http or https URL.
+func httpURL() rule {
+	return rule{value: func(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+		u, err := url.Parse(v.String())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "must be an http or https URL"
+		}
+		return ""
+	}}
+}
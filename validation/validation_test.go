@@ -0,0 +1,177 @@
+package validation
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	pb "github.com/renaldyhidayatt/movie_grpc/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  proto.Message
+		// fields are the violated fields, in order; none means valid.
+		fields []string
+	}{
+		{
+			name: "valid create",
+			msg: &pb.CreateMovieRequest{Movie: &pb.Movie{
+				Title:       "Heat",
+				Genres:      []string{"Crime", "Thriller"},
+				ReleaseYear: 1995,
+				AgeRating:   pb.AgeRating_AGE_RATING_R,
+				PosterUrl:   "https://example.com/heat.jpg",
+			}},
+		},
+		{
+			name:   "missing movie",
+			msg:    &pb.CreateMovieRequest{},
+			fields: []string{"movie"},
+		},
+		{
+			name:   "missing title and server-assigned id",
+			msg:    &pb.CreateMovieRequest{Movie: &pb.Movie{Id: "m1"}},
+			fields: []string{"movie.id", "movie.title"},
+		},
+		{
+			name:   "title counted in characters",
+			msg:    &pb.CreateMovieRequest{Movie: &pb.Movie{Title: strings.Repeat("é", maxTitleLength)}},
+			fields: nil,
+		},
+		{
+			name:   "title too long",
+			msg:    &pb.CreateMovieRequest{Movie: &pb.Movie{Title: strings.Repeat("a", maxTitleLength+1)}},
+			fields: []string{"movie.title"},
+		},
+		{
+			name: "one bad genre",
+			msg: &pb.CreateMovieRequest{Movie: &pb.Movie{
+				Title:  "Heat",
+				Genres: []string{"Crime", "Drama", strings.Repeat("g", maxGenreLength+1)},
+			}},
+			fields: []string{"movie.genres[2]"},
+		},
+		{
+			name:   "too many genres",
+			msg:    &pb.CreateMovieRequest{Movie: &pb.Movie{Title: "Heat", Genres: make([]string, maxGenres+1)}},
+			fields: []string{"movie.genres"},
+		},
+		{
+			name: "values out of range",
+			msg: &pb.CreateMovieRequest{Movie: &pb.Movie{
+				Title:          "Heat",
+				ReleaseYear:    1800,
+				RuntimeMinutes: -5,
+				AgeRating:      pb.AgeRating(42),
+				PosterUrl:      "ftp://example.com/heat.jpg",
+			}},
+			fields: []string{"movie.release_year", "movie.runtime_minutes", "movie.age_rating", "movie.poster_url"},
+		},
+		{
+			name:   "relative poster url",
+			msg:    &pb.CreateMovieRequest{Movie: &pb.Movie{Title: "Heat", PosterUrl: "/heat.jpg"}},
+			fields: []string{"movie.poster_url"},
+		},
+		{
+			name:   "update needs an id",
+			msg:    &pb.UpdateMovieRequest{Movie: &pb.Movie{Title: "Heat"}},
+			fields: []string{"movie.id"},
+		},
+		{
+			name:   "expected version zero",
+			msg:    &pb.UpdateMovieRequest{Movie: &pb.Movie{Id: "m1"}, ExpectedVersion: proto.Int64(0)},
+			fields: []string{"expected_version"},
+		},
+		{
+			name:   "update may clear the title",
+			msg:    &pb.UpdateMovieRequest{Movie: &pb.Movie{Id: "m1"}, ExpectedVersion: proto.Int64(3)},
+			fields: nil,
+		},
+		{
+			name:   "rating bounds",
+			msg:    &pb.ReadMoviesRequest{MinRating: proto.Float64(-1), MaxRating: proto.Float64(10), PageSize: maxPageSize + 1},
+			fields: []string{"min_rating", "page_size"},
+		},
+		{
+			name:   "search needs a query",
+			msg:    &pb.SearchMoviesRequest{},
+			fields: []string{"query"},
+		},
+		{
+			name:   "credit role",
+			msg:    &pb.CreateCreditRequest{Credit: &pb.Credit{MovieId: "m1", PersonId: "p1", Role: pb.CreditRole(99)}},
+			fields: []string{"credit.role"},
+		},
+		{
+			name:   "empty person",
+			msg:    &pb.CreatePersonRequest{},
+			fields: []string{"person"},
+		},
+		{
+			name:   "no rules",
+			msg:    &pb.ReadMoviesResponse{},
+			fields: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.msg)
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var verr *Error
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() = %v, want an *Error", err)
+			}
+			var fields []string
+			for _, v := range verr.Violations {
+				fields = append(fields, v.Field)
+			}
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("violated fields = %q, want %q", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	err := Validate(&pb.CreateMovieRequest{Movie: &pb.Movie{Id: "m1"}})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if badRequest == nil {
+		t.Fatalf("details = %v, want a BadRequest", st.Details())
+	}
+	want := map[string]string{
+		"movie.id":    "must not be set",
+		"movie.title": "is required",
+	}
+	if len(badRequest.GetFieldViolations()) != len(want) {
+		t.Fatalf("field violations = %v, want %v", badRequest.GetFieldViolations(), want)
+	}
+	for _, v := range badRequest.GetFieldViolations() {
+		if want[v.GetField()] != v.GetDescription() {
+			t.Errorf("%s: %q, want %q", v.GetField(), v.GetDescription(), want[v.GetField()])
+		}
+	}
+}